@@ -0,0 +1,3 @@
+data "stackit_affinity_groups" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
@@ -0,0 +1,28 @@
+data "stackit_cloudinit_config" "example" {
+  part = [
+    {
+      filename = "packages.yaml"
+      content  = <<-EOT
+        #cloud-config
+        packages:
+          - nginx
+      EOT
+    },
+    {
+      content_type = "text/x-shellscript"
+      content      = "#!/bin/bash\nsystemctl enable --now nginx"
+    },
+  ]
+}
+
+resource "stackit_server" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "example-server"
+  boot_volume = {
+    size        = 64
+    source_type = "image"
+    source_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  machine_type     = "g2i.1"
+  user_data_base64 = data.stackit_cloudinit_config.example.rendered
+}
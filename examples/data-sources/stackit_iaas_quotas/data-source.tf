@@ -0,0 +1,13 @@
+data "stackit_iaas_quotas" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Fail before creating servers if fewer than 8 vCPUs are left in the project
+resource "terraform_data" "vcpu_check" {
+  lifecycle {
+    precondition {
+      condition     = data.stackit_iaas_quotas.example.vcpu.limit - data.stackit_iaas_quotas.example.vcpu.usage >= 8
+      error_message = "The project has less than 8 vCPUs left."
+    }
+  }
+}
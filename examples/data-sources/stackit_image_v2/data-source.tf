@@ -25,4 +25,32 @@ data "stackit_image_v2" "filter_distro_version" {
     distro  = "debian"
     version = "11"
   }
-}
\ No newline at end of file
+}
+
+data "stackit_image_v2" "most_recent_arm" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name_regex  = "^Ubuntu 24.04"
+  most_recent = true
+  filter = {
+    architecture = "arm64"
+    owner_scope  = "public"
+  }
+}
+
+data "stackit_image_v2" "labels_filter" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  filter = {
+    labels = {
+      channel = "stable"
+    }
+  }
+}
+
+# returns the pinned image until the end of 2025, afterwards the most recent Ubuntu 24.04 image
+data "stackit_image_v2" "pinned" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  image_id    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  pin_until   = "2025-12-31T00:00:00Z"
+  name        = "Ubuntu 24.04"
+  most_recent = true
+}
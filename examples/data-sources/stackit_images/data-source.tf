@@ -0,0 +1,12 @@
+data "stackit_images" "ubuntu" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name_regex  = "^Ubuntu .*"
+  most_recent = true
+}
+
+data "stackit_images" "private" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  filter = {
+    owner_scope = "private"
+  }
+}
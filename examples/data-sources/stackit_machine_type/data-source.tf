@@ -18,4 +18,15 @@ data "stackit_machine_type" "intel_icelake_generic_filter" {
 data "stackit_machine_type" "no_match" {
   project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   filter     = "vcpus == 99"
-}
\ No newline at end of file
+}
+
+# smallest machine type with at least 4 vCPUs and 8 GB RAM on an AMD CPU
+data "stackit_machine_type" "smallest_amd" {
+  project_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  min_vcpus        = 4
+  min_ram          = 8192
+  cpu_architecture = "amd"
+  gpu              = false
+  sort_by          = "size"
+  sort_ascending   = true
+}
@@ -0,0 +1,15 @@
+data "stackit_machine_types" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  min_vcpus  = 2
+  min_ram    = 4096
+  extra_specs_match = {
+    overcommit = "1"
+  }
+  sort_by        = "ram"
+  sort_ascending = true
+}
+
+data "stackit_machine_types" "gpu" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  gpu        = true
+}
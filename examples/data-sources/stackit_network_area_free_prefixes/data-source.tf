@@ -0,0 +1,12 @@
+data "stackit_network_area_free_prefixes" "example" {
+  organization_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_area_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  prefix_length   = 24
+  limit           = 1
+}
+
+resource "stackit_network" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name        = "example-network"
+  ipv4_prefix = data.stackit_network_area_free_prefixes.example.prefixes[0]
+}
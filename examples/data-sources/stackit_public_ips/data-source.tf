@@ -0,0 +1,5 @@
+data "stackit_public_ips" "example" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  label_selector = "role=egress"
+  associated     = false
+}
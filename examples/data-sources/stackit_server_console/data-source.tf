@@ -0,0 +1,9 @@
+data "stackit_server_console" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  server_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  log_lines  = 50
+}
+
+output "console_log" {
+  value = data.stackit_server_console.example.console_log
+}
@@ -0,0 +1,9 @@
+data "stackit_ske_clusters" "example" {
+  project_id         = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name_regex         = "^prod-"
+  kubernetes_version = "1.31"
+  status             = "STATE_HEALTHY"
+  node_pool_labels = {
+    "team" = "platform"
+  }
+}
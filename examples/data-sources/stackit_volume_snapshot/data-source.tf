@@ -0,0 +1,11 @@
+data "stackit_volume_snapshot" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  snapshot_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Latest snapshot with a matching name and labels
+data "stackit_volume_snapshot" "latest" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name_regex     = "^golden-"
+  label_selector = "env=prod"
+}
@@ -7,12 +7,19 @@ resource "stackit_image" "example_image" {
   min_ram         = 5
 }
 
+resource "stackit_image" "example_image_from_url" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name        = "example-image-from-url"
+  disk_format = "qcow2"
+  source_url  = "https://cloud-images.ubuntu.com/noble/current/noble-server-cloudimg-amd64.img"
+}
+
 # Only use the import statement, if you want to import an existing image
-# Must set a configuration value for the local_file_path attribute as the provider has marked it as required.
+# Must set a configuration value for the local_file_path or source_url attribute as the provider has marked it as required.
 # Since this attribute is not fetched in general from the API call, after adding it this would replace your image resource after an terraform apply.
 # In order to prevent this you need to add:
 #lifecycle {
-#    ignore_changes = [ local_file_path ]
+#    ignore_changes = [ local_file_path, source_url ]
 #  }
 import {
   to = stackit_image.import-example
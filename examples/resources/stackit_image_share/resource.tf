@@ -0,0 +1,20 @@
+resource "stackit_image_share" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  image_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  projects = [
+    "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+    "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy",
+  ]
+}
+
+resource "stackit_image_share" "organization" {
+  project_id          = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  image_id            = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  parent_organization = true
+}
+
+# Only use the import statement, if you want to import an existing image share
+import {
+  to = stackit_image_share.import-example
+  id = "${var.project_id},${var.image_id}"
+}
@@ -0,0 +1,12 @@
+resource "stackit_image_share_consumer" "example" {
+  for_each            = toset(var.consumer_project_ids)
+  project_id          = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  image_id            = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  consumer_project_id = each.value
+}
+
+# Only use the import statement, if you want to import an existing image share consumer
+import {
+  to = stackit_image_share_consumer.import-example
+  id = "${var.project_id},${var.image_id},${var.consumer_project_id}"
+}
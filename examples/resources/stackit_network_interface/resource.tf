@@ -5,6 +5,15 @@ resource "stackit_network_interface" "example" {
   security_group_ids = ["xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"]
 }
 
+# Dual-stack interface of a keepalived/VRRP pair member, the virtual IPs are allowed in addition to the fixed IPs
+resource "stackit_network_interface" "vrrp_primary" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  ipv4              = "192.168.0.10"
+  ipv6              = "2001:db8::10"
+  allowed_addresses = ["192.168.0.100/32", "2001:db8::100/128"]
+}
+
 # Only use the import statement, if you want to import an existing network interface
 import {
   to = stackit_network_interface.import-example
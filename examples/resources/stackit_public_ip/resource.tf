@@ -6,6 +6,18 @@ resource "stackit_public_ip" "example" {
   }
 }
 
+# Keeps the egress IP stable across rebuilds: an unassociated public IP with the labels
+# is reused if there is one, and the public IP is kept when the resource is destroyed
+resource "stackit_public_ip" "egress" {
+  project_id           = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_interface_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  labels = {
+    "role" = "egress"
+  }
+  adopt_existing   = true
+  retain_on_delete = true
+}
+
 # Only use the import statement, if you want to import an existing public ip
 import {
   to = stackit_public_ip.import-example
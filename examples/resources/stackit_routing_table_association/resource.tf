@@ -0,0 +1,13 @@
+resource "stackit_routing_table_association" "example" {
+  organization_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_area_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  project_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  network_id       = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  routing_table_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
+
+# Only use the import statement, if you want to import an existing routing table association
+import {
+  to = stackit_routing_table_association.import-example
+  id = "${var.organization_id},${var.region},${var.network_area_id},${var.project_id},${var.network_id}"
+}
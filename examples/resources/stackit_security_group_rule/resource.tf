@@ -11,6 +11,17 @@ resource "stackit_security_group_rule" "example" {
   }
 }
 
+resource "stackit_security_group_rule" "https" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  security_group_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  direction         = "ingress"
+  protocol = {
+    name = "tcp"
+  }
+  # Equivalent to port_range = { min = 443, max = 443 }
+  ports = "https"
+}
+
 # Only use the import statement, if you want to import an existing security group rule
 # Note: There will be a conflict which needs to be resolved manually.
 # Attribute "protocol.number" cannot be specified when "protocol.name" is specified.
@@ -0,0 +1,41 @@
+resource "stackit_security_group_rules" "example" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  security_group_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  rules = [
+    {
+      direction = "ingress"
+      protocol = {
+        name = "tcp"
+      }
+      port_range = {
+        min = 22
+        max = 22
+      }
+      ip_range = "192.168.0.0/24"
+    },
+    {
+      direction = "ingress"
+      protocol = {
+        name = "icmp"
+      }
+      icmp_parameters = {
+        code = 0
+        type = 8
+      }
+    },
+    {
+      direction  = "egress"
+      ether_type = "IPv4"
+    },
+    {
+      direction  = "egress"
+      ether_type = "IPv6"
+    },
+  ]
+}
+
+# Only use the import statement, if you want to import all rules of an existing security group
+import {
+  to = stackit_security_group_rules.import-example
+  id = "${var.project_id},${var.security_group_id}"
+}
@@ -1,5 +1,21 @@
 resource "stackit_ske_kubeconfig" "example" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name   = "example-cluster"
+  refresh        = true
+  refresh_before = "15m"
+}
+
+# Kubeconfig without static credentials, which uses the STACKIT CLI to log in
+resource "stackit_ske_kubeconfig" "login" {
   project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   cluster_name = "example-cluster"
-  refresh      = true
+  type         = "login"
+}
+
+# Configure the kubernetes provider with the credentials of an admin kubeconfig
+provider "kubernetes" {
+  host                   = stackit_ske_kubeconfig.example.host
+  cluster_ca_certificate = stackit_ske_kubeconfig.example.cluster_ca_certificate
+  client_certificate     = stackit_ske_kubeconfig.example.client_certificate
+  client_key             = stackit_ske_kubeconfig.example.client_key
 }
@@ -0,0 +1,17 @@
+resource "stackit_volume_backup" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "my_backup"
+  source = {
+    type = "volume"
+    id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  labels = {
+    "key" = "value"
+  }
+}
+
+# Only use the import statement, if you want to import an existing volume backup
+import {
+  to = stackit_volume_backup.import-example
+  id = "${var.project_id},${var.backup_id}"
+}
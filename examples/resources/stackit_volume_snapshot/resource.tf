@@ -0,0 +1,14 @@
+resource "stackit_volume_snapshot" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  volume_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "my_snapshot"
+  labels = {
+    "key" = "value"
+  }
+}
+
+# Only use the import statement, if you want to import an existing volume snapshot
+import {
+  to = stackit_volume_snapshot.import-example
+  id = "${var.project_id},${var.snapshot_id}"
+}
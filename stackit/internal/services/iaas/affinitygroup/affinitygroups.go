@@ -0,0 +1,199 @@
+package affinitygroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSource              = &affinityGroupsDatasource{}
+	_ datasource.DataSourceWithConfigure = &affinityGroupsDatasource{}
+)
+
+// AffinityGroupsModel is the model of the affinity groups data source
+type AffinityGroupsModel struct {
+	Id             types.String `tfsdk:"id"` // required by Terraform to identify state
+	ProjectId      types.String `tfsdk:"project_id"`
+	AffinityGroups types.List   `tfsdk:"affinity_groups"`
+}
+
+// affinityGroupTypes are the types of an element of AffinityGroupsModel.AffinityGroups
+var affinityGroupTypes = map[string]attr.Type{
+	"affinity_group_id": types.StringType,
+	"name":              types.StringType,
+	"policy":            types.StringType,
+	"members":           types.ListType{ElemType: types.StringType},
+}
+
+func NewAffinityGroupsDatasource() datasource.DataSource {
+	return &affinityGroupsDatasource{}
+}
+
+type affinityGroupsDatasource struct {
+	client *iaas.APIClient
+}
+
+func (d *affinityGroupsDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+func (d *affinityGroupsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_affinity_groups"
+}
+
+func (d *affinityGroupsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptionMain := "Affinity groups data source schema. Lists all affinity groups of a project. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description:         descriptionMain,
+		MarkdownDescription: descriptionMain,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT Project ID to which the affinity groups are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"affinity_groups": schema.ListNestedAttribute{
+				Description: "The affinity groups of the project.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"affinity_group_id": schema.StringAttribute{
+							Description: "The affinity group ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the affinity group.",
+							Computed:    true,
+						},
+						"policy": schema.StringAttribute{
+							Description: "The policy of the affinity group.",
+							Computed:    true,
+						},
+						"members": schema.ListAttribute{
+							Description: "The servers that are part of the affinity group.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *affinityGroupsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model AffinityGroupsModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	affinityGroupsResp, err := d.client.ListAffinityGroupsExecute(ctx, projectId)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading affinity groups",
+			fmt.Sprintf("Unable to list affinity groups of project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapAffinityGroupsFields(ctx, affinityGroupsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading affinity groups", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Affinity groups read")
+}
+
+func mapAffinityGroupsFields(ctx context.Context, affinityGroupsResp *iaas.AffinityGroupListResponse, model *AffinityGroupsModel) error {
+	if affinityGroupsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("nil model")
+	}
+
+	affinityGroups := []attr.Value{}
+	for _, affinityGroup := range affinityGroupsResp.GetItems() {
+		if affinityGroup.Id == nil {
+			return fmt.Errorf("affinity group id not present")
+		}
+
+		members := types.ListValueMust(types.StringType, []attr.Value{})
+		if affinityGroup.Members != nil {
+			var diags diag.Diagnostics
+			members, diags = types.ListValueFrom(ctx, types.StringType, *affinityGroup.Members)
+			if diags.HasError() {
+				return fmt.Errorf("convert members to StringValue list: %w", core.DiagsToError(diags))
+			}
+		}
+
+		affinityGroupTF, diags := types.ObjectValue(affinityGroupTypes, map[string]attr.Value{
+			"affinity_group_id": types.StringPointerValue(affinityGroup.Id),
+			"name":              types.StringPointerValue(affinityGroup.Name),
+			"policy":            types.StringPointerValue(affinityGroup.Policy),
+			"members":           members,
+		})
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+		affinityGroups = append(affinityGroups, affinityGroupTF)
+	}
+
+	affinityGroupsTF, diags := types.ListValue(types.ObjectType{AttrTypes: affinityGroupTypes}, affinityGroups)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+
+	model.Id = types.StringValue(model.ProjectId.ValueString())
+	model.AffinityGroups = affinityGroupsTF
+	return nil
+}
@@ -0,0 +1,112 @@
+package affinitygroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapAffinityGroupsFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaas.AffinityGroupListResponse
+		expected    AffinityGroupsModel
+		isValid     bool
+	}{
+		{
+			"empty_list",
+			&iaas.AffinityGroupListResponse{
+				Items: &[]iaas.AffinityGroup{},
+			},
+			AffinityGroupsModel{
+				Id:             types.StringValue("pid"),
+				ProjectId:      types.StringValue("pid"),
+				AffinityGroups: types.ListValueMust(types.ObjectType{AttrTypes: affinityGroupTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"values_ok",
+			&iaas.AffinityGroupListResponse{
+				Items: &[]iaas.AffinityGroup{
+					{
+						Id:      utils.Ptr("aid1"),
+						Name:    utils.Ptr("group1"),
+						Policy:  utils.Ptr("hard-anti-affinity"),
+						Members: &[]string{"sid1", "sid2"},
+					},
+					{
+						Id:     utils.Ptr("aid2"),
+						Name:   utils.Ptr("group2"),
+						Policy: utils.Ptr("soft-affinity"),
+					},
+				},
+			},
+			AffinityGroupsModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				AffinityGroups: types.ListValueMust(types.ObjectType{AttrTypes: affinityGroupTypes}, []attr.Value{
+					types.ObjectValueMust(affinityGroupTypes, map[string]attr.Value{
+						"affinity_group_id": types.StringValue("aid1"),
+						"name":              types.StringValue("group1"),
+						"policy":            types.StringValue("hard-anti-affinity"),
+						"members": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("sid1"),
+							types.StringValue("sid2"),
+						}),
+					}),
+					types.ObjectValueMust(affinityGroupTypes, map[string]attr.Value{
+						"affinity_group_id": types.StringValue("aid2"),
+						"name":              types.StringValue("group2"),
+						"policy":            types.StringValue("soft-affinity"),
+						"members":           types.ListValueMust(types.StringType, []attr.Value{}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"no_affinity_group_id",
+			&iaas.AffinityGroupListResponse{
+				Items: &[]iaas.AffinityGroup{
+					{
+						Name: utils.Ptr("group1"),
+					},
+				},
+			},
+			AffinityGroupsModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			AffinityGroupsModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &AffinityGroupsModel{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapAffinityGroupsFields(context.Background(), tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -28,6 +29,19 @@ var (
 	_ datasource.DataSourceWithConfigure = &affinityGroupDatasource{}
 )
 
+// DataSourceModel is the model of the affinity group data source
+type DataSourceModel struct {
+	Model
+	MemberServers types.List `tfsdk:"member_servers"`
+}
+
+// memberServerTypes are the types of an element of DataSourceModel.MemberServers
+var memberServerTypes = map[string]attr.Type{
+	"server_id":         types.StringType,
+	"name":              types.StringType,
+	"availability_zone": types.StringType,
+}
+
 func NewAffinityGroupDatasource() datasource.DataSource {
 	return &affinityGroupDatasource{}
 }
@@ -96,7 +110,7 @@ func (d *affinityGroupDatasource) Schema(_ context.Context, _ datasource.SchemaR
 				Computed:    true,
 			},
 			"members": schema.ListAttribute{
-				Description: descriptionMain,
+				Description: "The servers that are part of the affinity group.",
 				Computed:    true,
 				ElementType: types.StringType,
 				Validators: []validator.List{
@@ -105,12 +119,32 @@ func (d *affinityGroupDatasource) Schema(_ context.Context, _ datasource.SchemaR
 					),
 				},
 			},
+			"member_servers": schema.ListNestedAttribute{
+				Description: "The servers that are part of the affinity group, together with the availability zone they are placed in.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.StringAttribute{
+							Description: "The server ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the server.",
+							Computed:    true,
+						},
+						"availability_zone": schema.StringAttribute{
+							Description: "The availability zone of the server.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (d *affinityGroupDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model DataSourceModel
 	diags := req.Config.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -137,9 +171,26 @@ func (d *affinityGroupDatasource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	err = mapFields(ctx, affinityGroupResp, &model)
+	err = mapFields(ctx, affinityGroupResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading affinity group", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	var servers []iaas.Server
+	if len(affinityGroupResp.GetMembers()) > 0 {
+		serversResp, err := d.client.ListServersExecute(ctx, projectId)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading affinity group", fmt.Sprintf("Listing servers of the project: %v", err))
+			return
+		}
+		servers = serversResp.GetItems()
+	}
+
+	err = mapMemberServers(affinityGroupResp.GetMembers(), servers, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading affinity group", fmt.Sprintf("Processing member servers: %v", err))
+		return
 	}
 
 	diags = resp.State.Set(ctx, model)
@@ -149,3 +200,43 @@ func (d *affinityGroupDatasource) Read(ctx context.Context, req datasource.ReadR
 	}
 	tflog.Info(ctx, "Affinity group read")
 }
+
+// mapMemberServers sets the member servers of the affinity group, looking up their name and availability zone in the given servers.
+// Members that are not found (e.g. because they are being deleted) are listed without name and availability zone.
+func mapMemberServers(members []string, servers []iaas.Server, model *DataSourceModel) error {
+	if model == nil {
+		return fmt.Errorf("nil model")
+	}
+
+	serversById := make(map[string]*iaas.Server, len(servers))
+	for i := range servers {
+		if servers[i].Id != nil {
+			serversById[*servers[i].Id] = &servers[i]
+		}
+	}
+
+	memberServers := []attr.Value{}
+	for _, member := range members {
+		name, availabilityZone := types.StringNull(), types.StringNull()
+		if server, ok := serversById[member]; ok {
+			name = types.StringPointerValue(server.Name)
+			availabilityZone = types.StringPointerValue(server.AvailabilityZone)
+		}
+		memberServer, diags := types.ObjectValue(memberServerTypes, map[string]attr.Value{
+			"server_id":         types.StringValue(member),
+			"name":              name,
+			"availability_zone": availabilityZone,
+		})
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+		memberServers = append(memberServers, memberServer)
+	}
+
+	memberServersTF, diags := types.ListValue(types.ObjectType{AttrTypes: memberServerTypes}, memberServers)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.MemberServers = memberServersTF
+	return nil
+}
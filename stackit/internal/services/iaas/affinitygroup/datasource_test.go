@@ -0,0 +1,98 @@
+package affinitygroup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapMemberServers(t *testing.T) {
+	tests := []struct {
+		description string
+		members     []string
+		servers     []iaas.Server
+		expected    types.List
+		isValid     bool
+	}{
+		{
+			"no_members",
+			nil,
+			nil,
+			types.ListValueMust(types.ObjectType{AttrTypes: memberServerTypes}, []attr.Value{}),
+			true,
+		},
+		{
+			"members_with_availability_zones",
+			[]string{"sid1", "sid2"},
+			[]iaas.Server{
+				{
+					Id:               utils.Ptr("sid2"),
+					Name:             utils.Ptr("server2"),
+					AvailabilityZone: utils.Ptr("eu01-2"),
+				},
+				{
+					Id:               utils.Ptr("sid1"),
+					Name:             utils.Ptr("server1"),
+					AvailabilityZone: utils.Ptr("eu01-1"),
+				},
+				{
+					Id:               utils.Ptr("sid3"),
+					Name:             utils.Ptr("server3"),
+					AvailabilityZone: utils.Ptr("eu01-3"),
+				},
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: memberServerTypes}, []attr.Value{
+				types.ObjectValueMust(memberServerTypes, map[string]attr.Value{
+					"server_id":         types.StringValue("sid1"),
+					"name":              types.StringValue("server1"),
+					"availability_zone": types.StringValue("eu01-1"),
+				}),
+				types.ObjectValueMust(memberServerTypes, map[string]attr.Value{
+					"server_id":         types.StringValue("sid2"),
+					"name":              types.StringValue("server2"),
+					"availability_zone": types.StringValue("eu01-2"),
+				}),
+			}),
+			true,
+		},
+		{
+			"member_not_found",
+			[]string{"sid1"},
+			[]iaas.Server{
+				{
+					Id: utils.Ptr("sid2"),
+				},
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: memberServerTypes}, []attr.Value{
+				types.ObjectValueMust(memberServerTypes, map[string]attr.Value{
+					"server_id":         types.StringValue("sid1"),
+					"name":              types.StringNull(),
+					"availability_zone": types.StringNull(),
+				}),
+			}),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &DataSourceModel{}
+			err := mapMemberServers(tt.members, tt.servers, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model.MemberServers, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
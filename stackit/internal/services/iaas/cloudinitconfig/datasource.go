@@ -0,0 +1,244 @@
+package cloudinitconfig
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &cloudinitConfigDataSource{}
+)
+
+const (
+	defaultBoundary    = "MIMEBOUNDARY"
+	defaultContentType = "text/cloud-config"
+)
+
+type DataSourceModel struct {
+	Id           types.String `tfsdk:"id"` // needed by TF
+	Gzip         types.Bool   `tfsdk:"gzip"`
+	Base64Encode types.Bool   `tfsdk:"base64_encode"`
+	Boundary     types.String `tfsdk:"boundary"`
+	Parts        types.List   `tfsdk:"part"`
+	Rendered     types.String `tfsdk:"rendered"`
+}
+
+// Struct corresponding to DataSourceModel.Parts[i]
+type partModel struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Content     types.String `tfsdk:"content"`
+	Filename    types.String `tfsdk:"filename"`
+	MergeType   types.String `tfsdk:"merge_type"`
+}
+
+// Types corresponding to partModel
+var partTypes = map[string]attr.Type{
+	"content_type": basetypes.StringType{},
+	"content":      basetypes.StringType{},
+	"filename":     basetypes.StringType{},
+	"merge_type":   basetypes.StringType{},
+}
+
+// NewCloudinitConfigDataSource is a helper function to simplify the provider implementation.
+func NewCloudinitConfigDataSource() datasource.DataSource {
+	return &cloudinitConfigDataSource{}
+}
+
+// cloudinitConfigDataSource is the data source implementation. It renders the config locally and doesn't call any API.
+type cloudinitConfigDataSource struct{}
+
+// Metadata returns the data source type name.
+func (d *cloudinitConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudinit_config"
+}
+
+// Schema defines the schema for the data source.
+func (d *cloudinitConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Renders a multipart MIME cloud-init config from multiple parts, to be used as user data of a `stackit_server`. The config is rendered locally without calling any API."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is the SHA-256 checksum of `rendered`.",
+				Computed:    true,
+			},
+			"gzip": schema.BoolAttribute{
+				Description: "Whether to compress the rendered config with gzip. Requires `base64_encode` to be `true`. Defaults to `true`.",
+				Optional:    true,
+			},
+			"base64_encode": schema.BoolAttribute{
+				Description: "Whether to base64 encode the rendered config. Base64 encoded configs must be passed to the `user_data_base64` attribute of `stackit_server`. Defaults to `true`.",
+				Optional:    true,
+			},
+			"boundary": schema.StringAttribute{
+				Description: fmt.Sprintf("The boundary separating the parts of the multipart MIME config. Defaults to `%s`.", defaultBoundary),
+				Optional:    true,
+			},
+			"part": schema.ListNestedAttribute{
+				Description: "The parts of the config. Parts are merged by cloud-init in the given order.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content_type": schema.StringAttribute{
+							Description: fmt.Sprintf("The MIME type of the part, e.g. `text/cloud-config` or `text/x-shellscript`. Defaults to `%s`.", defaultContentType),
+							Optional:    true,
+						},
+						"content": schema.StringAttribute{
+							Description: "The content of the part. Cloud-config content, starting with `#cloud-config`, must be valid YAML.",
+							Required:    true,
+							Validators: []validator.String{
+								validate.UserData(false),
+							},
+						},
+						"filename": schema.StringAttribute{
+							Description: "The filename of the part, set in the `Content-Disposition` header.",
+							Optional:    true,
+						},
+						"merge_type": schema.StringAttribute{
+							Description: "Controls how cloud-init merges this part with the previous parts, set in the `X-Merge-Type` header, e.g. `list(append)+dict(recurse_array)+str()`.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"rendered": schema.StringAttribute{
+				Description: "The rendered multipart MIME config.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cloudinitConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := renderConfig(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rendering cloud-init config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "cloud-init config rendered")
+}
+
+// renderConfig renders the parts of the model into a multipart MIME config and sets the computed fields
+func renderConfig(ctx context.Context, model *DataSourceModel) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	gzipEnabled := model.Gzip.IsNull() || model.Gzip.ValueBool()
+	base64Enabled := model.Base64Encode.IsNull() || model.Base64Encode.ValueBool()
+	if gzipEnabled && !base64Enabled {
+		return fmt.Errorf("gzip compressed configs must be base64 encoded, set base64_encode to true")
+	}
+
+	boundary := defaultBoundary
+	if !model.Boundary.IsNull() {
+		boundary = model.Boundary.ValueString()
+	}
+
+	var parts []partModel
+	diags := model.Parts.ElementsAs(ctx, &parts, false)
+	if diags.HasError() {
+		return fmt.Errorf("mapping parts: %w", core.DiagsToError(diags))
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return fmt.Errorf("invalid boundary: %w", err)
+	}
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", boundary)
+
+	for i := range parts {
+		header := partHeader(&parts[i])
+		partWriter, err := writer.CreatePart(header)
+		if err != nil {
+			return fmt.Errorf("creating part %d: %w", i, err)
+		}
+		if _, err := partWriter.Write([]byte(parts[i].Content.ValueString())); err != nil {
+			return fmt.Errorf("writing part %d: %w", i, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("closing multipart config: %w", err)
+	}
+
+	rendered := buf.Bytes()
+	if gzipEnabled {
+		var gzipBuf bytes.Buffer
+		gzipWriter := gzip.NewWriter(&gzipBuf)
+		if _, err := gzipWriter.Write(rendered); err != nil {
+			return fmt.Errorf("compressing config: %w", err)
+		}
+		if err := gzipWriter.Close(); err != nil {
+			return fmt.Errorf("compressing config: %w", err)
+		}
+		rendered = gzipBuf.Bytes()
+	}
+
+	var renderedString string
+	if base64Enabled {
+		renderedString = base64.StdEncoding.EncodeToString(rendered)
+	} else {
+		renderedString = string(rendered)
+	}
+
+	checksum := sha256.Sum256([]byte(renderedString))
+	model.Id = types.StringValue(hex.EncodeToString(checksum[:]))
+	model.Rendered = types.StringValue(renderedString)
+	return nil
+}
+
+func partHeader(part *partModel) textproto.MIMEHeader {
+	contentType := defaultContentType
+	if !part.ContentType.IsNull() && part.ContentType.ValueString() != "" {
+		contentType = part.ContentType.ValueString()
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("MIME-Version", "1.0")
+	header.Set("Content-Transfer-Encoding", "7bit")
+	if !part.Filename.IsNull() && part.Filename.ValueString() != "" {
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.Filename.ValueString()))
+	}
+	if !part.MergeType.IsNull() && part.MergeType.ValueString() != "" {
+		header.Set("X-Merge-Type", part.MergeType.ValueString())
+	}
+	return header
+}
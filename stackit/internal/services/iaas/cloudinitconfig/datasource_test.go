@@ -0,0 +1,147 @@
+package cloudinitconfig
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRenderConfig(t *testing.T) {
+	parts := types.ListValueMust(types.ObjectType{AttrTypes: partTypes}, []attr.Value{
+		types.ObjectValueMust(partTypes, map[string]attr.Value{
+			"content_type": types.StringNull(),
+			"content":      types.StringValue("#cloud-config\npackages:\n  - nginx\n"),
+			"filename":     types.StringValue("packages.yaml"),
+			"merge_type":   types.StringValue("list(append)+dict(recurse_array)+str()"),
+		}),
+		types.ObjectValueMust(partTypes, map[string]attr.Value{
+			"content_type": types.StringValue("text/x-shellscript"),
+			"content":      types.StringValue("#!/bin/bash\necho hello\n"),
+			"filename":     types.StringNull(),
+			"merge_type":   types.StringNull(),
+		}),
+	})
+	expectedMultipart := "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"\r\n" +
+		"--MIMEBOUNDARY\r\n" +
+		"Content-Disposition: attachment; filename=\"packages.yaml\"\r\n" +
+		"Content-Transfer-Encoding: 7bit\r\n" +
+		"Content-Type: text/cloud-config\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"X-Merge-Type: list(append)+dict(recurse_array)+str()\r\n" +
+		"\r\n" +
+		"#cloud-config\npackages:\n  - nginx\n\r\n" +
+		"--MIMEBOUNDARY\r\n" +
+		"Content-Transfer-Encoding: 7bit\r\n" +
+		"Content-Type: text/x-shellscript\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"\r\n" +
+		"#!/bin/bash\necho hello\n\r\n" +
+		"--MIMEBOUNDARY--\r\n"
+
+	tests := []struct {
+		description string
+		input       DataSourceModel
+		expected    string
+		isValid     bool
+	}{
+		{
+			"plain",
+			DataSourceModel{
+				Gzip:         types.BoolValue(false),
+				Base64Encode: types.BoolValue(false),
+				Parts:        parts,
+			},
+			expectedMultipart,
+			true,
+		},
+		{
+			"base64",
+			DataSourceModel{
+				Gzip:         types.BoolValue(false),
+				Base64Encode: types.BoolValue(true),
+				Parts:        parts,
+			},
+			expectedMultipart,
+			true,
+		},
+		{
+			"default_gzip_base64",
+			DataSourceModel{
+				Gzip:         types.BoolNull(),
+				Base64Encode: types.BoolNull(),
+				Parts:        parts,
+			},
+			expectedMultipart,
+			true,
+		},
+		{
+			"gzip_without_base64_fail",
+			DataSourceModel{
+				Gzip:         types.BoolValue(true),
+				Base64Encode: types.BoolValue(false),
+				Parts:        parts,
+			},
+			"",
+			false,
+		},
+		{
+			"invalid_boundary_fail",
+			DataSourceModel{
+				Gzip:         types.BoolValue(false),
+				Base64Encode: types.BoolValue(false),
+				Boundary:     types.StringValue("invalid boundary\n"),
+				Parts:        parts,
+			},
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := renderConfig(context.Background(), &tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if !tt.isValid {
+				return
+			}
+
+			rendered := []byte(tt.input.Rendered.ValueString())
+			if tt.input.Base64Encode.IsNull() || tt.input.Base64Encode.ValueBool() {
+				rendered, err = base64.StdEncoding.DecodeString(string(rendered))
+				if err != nil {
+					t.Fatalf("Rendered config is not base64 encoded: %v", err)
+				}
+			}
+			if tt.input.Gzip.IsNull() || tt.input.Gzip.ValueBool() {
+				reader, err := gzip.NewReader(bytes.NewReader(rendered))
+				if err != nil {
+					t.Fatalf("Rendered config is not gzip compressed: %v", err)
+				}
+				rendered, err = io.ReadAll(reader)
+				if err != nil {
+					t.Fatalf("Rendered config is not gzip compressed: %v", err)
+				}
+			}
+			diff := cmp.Diff(string(rendered), tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+			if tt.input.Id.ValueString() == "" {
+				t.Fatalf("Id is not set")
+			}
+		})
+	}
+}
@@ -80,6 +80,9 @@ var (
 	//go:embed testdata/resource-volume-max.tf
 	resourceVolumeMaxConfig string
 
+	//go:embed testdata/resource-volume-snapshot-backup.tf
+	resourceVolumeSnapshotBackupConfig string
+
 	//go:embed testdata/resource-affinity-group-min.tf
 	resourceAffinityGroupMinConfig string
 
@@ -229,6 +232,24 @@ var testConfigVolumeVarsMaxUpdated = func() config.Variables {
 	return updatedConfig
 }()
 
+var testConfigVolumeSnapshotBackupVars = config.Variables{
+	"project_id":        config.StringVariable(testutil.ProjectId),
+	"availability_zone": config.StringVariable("eu01-1"),
+	"size":              config.IntegerVariable(16),
+	"name":              config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
+	"label":             config.StringVariable("label"),
+}
+
+var testConfigVolumeSnapshotBackupVarsUpdated = func() config.Variables {
+	updatedConfig := config.Variables{}
+	for k, v := range testConfigVolumeSnapshotBackupVars {
+		updatedConfig[k] = v
+	}
+	updatedConfig["name"] = config.StringVariable(fmt.Sprintf("%s-updated", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["name"])))
+	updatedConfig["label"] = config.StringVariable("updated")
+	return updatedConfig
+}()
+
 var testConfigNetworkV1VarsMin = config.Variables{
 	"project_id": config.StringVariable(testutil.ProjectId),
 	"name":       config.StringVariable(fmt.Sprintf("tf-acc-%s", acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum))),
@@ -1785,6 +1806,159 @@ func TestAccVolumeMax(t *testing.T) {
 	})
 }
 
+func TestAccVolumeSnapshotBackup(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutil.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			// Creation
+			{
+				ConfigVariables: testConfigVolumeSnapshotBackupVars,
+				Config:          fmt.Sprintf("%s\n%s", testutil.IaaSProviderConfig(), resourceVolumeSnapshotBackupConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Snapshot
+					resource.TestCheckResourceAttr("stackit_volume_snapshot.snapshot", "project_id", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["project_id"])),
+					resource.TestCheckResourceAttrSet("stackit_volume_snapshot.snapshot", "snapshot_id"),
+					resource.TestCheckResourceAttrPair(
+						"stackit_volume_snapshot.snapshot", "volume_id",
+						"stackit_volume.volume", "volume_id",
+					),
+					resource.TestCheckResourceAttr("stackit_volume_snapshot.snapshot", "name", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["name"])),
+					resource.TestCheckResourceAttr("stackit_volume_snapshot.snapshot", "labels.acc-test", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["label"])),
+					resource.TestCheckResourceAttr("stackit_volume_snapshot.snapshot", "size", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["size"])),
+					resource.TestCheckResourceAttr("stackit_volume_snapshot.snapshot", "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet("stackit_volume_snapshot.snapshot", "created_at"),
+
+					// Backup
+					resource.TestCheckResourceAttr("stackit_volume_backup.backup", "project_id", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["project_id"])),
+					resource.TestCheckResourceAttrSet("stackit_volume_backup.backup", "backup_id"),
+					resource.TestCheckResourceAttr("stackit_volume_backup.backup", "name", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["name"])),
+					resource.TestCheckResourceAttr("stackit_volume_backup.backup", "labels.acc-test", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["label"])),
+					resource.TestCheckResourceAttr("stackit_volume_backup.backup", "source.type", "snapshot"),
+					resource.TestCheckResourceAttrPair(
+						"stackit_volume_backup.backup", "source.id",
+						"stackit_volume_snapshot.snapshot", "snapshot_id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"stackit_volume_backup.backup", "snapshot_id",
+						"stackit_volume_snapshot.snapshot", "snapshot_id",
+					),
+					resource.TestCheckResourceAttr("stackit_volume_backup.backup", "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet("stackit_volume_backup.backup", "created_at"),
+				),
+			},
+			// Data source
+			{
+				ConfigVariables: testConfigVolumeSnapshotBackupVars,
+				Config: fmt.Sprintf(`
+					%s
+					%s
+
+					data "stackit_volume_snapshot" "snapshot" {
+						project_id  = stackit_volume_snapshot.snapshot.project_id
+						snapshot_id = stackit_volume_snapshot.snapshot.snapshot_id
+					}
+
+					data "stackit_volume_snapshot" "latest" {
+						project_id     = stackit_volume_snapshot.snapshot.project_id
+						name_regex     = "^${stackit_volume_snapshot.snapshot.name}$"
+						label_selector = "acc-test=${stackit_volume_snapshot.snapshot.labels["acc-test"]}"
+					}
+
+					data "stackit_volume_backup" "backup" {
+						project_id = stackit_volume_backup.backup.project_id
+						backup_id  = stackit_volume_backup.backup.backup_id
+					}
+
+					data "stackit_volume_backup" "latest" {
+						project_id = stackit_volume_backup.backup.project_id
+						volume_id  = stackit_volume_backup.backup.volume_id
+						name_regex = "^${stackit_volume_backup.backup.name}$"
+					}
+					`,
+					testutil.IaaSProviderConfig(), resourceVolumeSnapshotBackupConfig,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Snapshot
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_volume_snapshot.snapshot", "snapshot_id",
+						"stackit_volume_snapshot.snapshot", "snapshot_id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_volume_snapshot.snapshot", "volume_id",
+						"stackit_volume.volume", "volume_id",
+					),
+					resource.TestCheckResourceAttr("data.stackit_volume_snapshot.snapshot", "name", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["name"])),
+					resource.TestCheckResourceAttr("data.stackit_volume_snapshot.snapshot", "labels.acc-test", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["label"])),
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_volume_snapshot.latest", "snapshot_id",
+						"stackit_volume_snapshot.snapshot", "snapshot_id",
+					),
+
+					// Backup
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_volume_backup.backup", "backup_id",
+						"stackit_volume_backup.backup", "backup_id",
+					),
+					resource.TestCheckResourceAttr("data.stackit_volume_backup.backup", "name", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["name"])),
+					resource.TestCheckResourceAttr("data.stackit_volume_backup.backup", "labels.acc-test", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVars["label"])),
+					resource.TestCheckResourceAttrPair(
+						"data.stackit_volume_backup.latest", "backup_id",
+						"stackit_volume_backup.backup", "backup_id",
+					),
+				),
+			},
+			// Import
+			{
+				ConfigVariables: testConfigVolumeSnapshotBackupVars,
+				ResourceName:    "stackit_volume_snapshot.snapshot",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_volume_snapshot.snapshot"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find resource stackit_volume_snapshot.snapshot")
+					}
+					snapshotId, ok := r.Primary.Attributes["snapshot_id"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find attribute snapshot_id")
+					}
+					return fmt.Sprintf("%s,%s", testutil.ProjectId, snapshotId), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ConfigVariables: testConfigVolumeSnapshotBackupVars,
+				ResourceName:    "stackit_volume_backup.backup",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_volume_backup.backup"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find resource stackit_volume_backup.backup")
+					}
+					backupId, ok := r.Primary.Attributes["backup_id"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find attribute backup_id")
+					}
+					return fmt.Sprintf("%s,%s", testutil.ProjectId, backupId), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update
+			{
+				ConfigVariables: testConfigVolumeSnapshotBackupVarsUpdated,
+				Config:          fmt.Sprintf("%s\n%s", testutil.IaaSProviderConfig(), resourceVolumeSnapshotBackupConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_volume_snapshot.snapshot", "name", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVarsUpdated["name"])),
+					resource.TestCheckResourceAttr("stackit_volume_snapshot.snapshot", "labels.acc-test", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVarsUpdated["label"])),
+					resource.TestCheckResourceAttr("stackit_volume_backup.backup", "name", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVarsUpdated["name"])),
+					resource.TestCheckResourceAttr("stackit_volume_backup.backup", "labels.acc-test", testutil.ConvertConfigVariable(testConfigVolumeSnapshotBackupVarsUpdated["label"])),
+				),
+			},
+			// Deletion is done by the framework implicitly
+		},
+	})
+}
+
 func TestAccServerMin(t *testing.T) {
 	t.Logf("TestAccServerMin name: %s", testutil.ConvertConfigVariable(testConfigServerVarsMin["name"]))
 	resource.ParallelTest(t, resource.TestCase{
@@ -4238,6 +4412,8 @@ func testAccCheckDestroy(s *terraform.State) error {
 		testAccCheckNetworkInterfaceDestroy,
 		testAccCheckNetworkAreaDestroy,
 		testAccCheckIaaSVolumeDestroy,
+		testAccCheckIaaSVolumeBackupDestroy,
+		testAccCheckIaaSVolumeSnapshotDestroy,
 		testAccCheckServerDestroy,
 		testAccCheckAffinityGroupDestroy,
 		testAccCheckIaaSSecurityGroupDestroy,
@@ -4486,6 +4662,100 @@ func testAccCheckIaaSVolumeDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckIaaSVolumeSnapshotDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	var client *iaas.APIClient
+	var err error
+	if testutil.IaaSCustomEndpoint == "" {
+		client, err = iaas.NewAPIClient(
+			stackitSdkConfig.WithRegion("eu01"),
+		)
+	} else {
+		client, err = iaas.NewAPIClient(
+			stackitSdkConfig.WithEndpoint(testutil.IaaSCustomEndpoint),
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	snapshotsToDestroy := []string{}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "stackit_volume_snapshot" {
+			continue
+		}
+		// snapshot terraform ID: "[project_id],[snapshot_id]"
+		snapshotId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		snapshotsToDestroy = append(snapshotsToDestroy, snapshotId)
+	}
+
+	snapshotsResp, err := client.ListSnapshotsExecute(ctx, testutil.ProjectId)
+	if err != nil {
+		return fmt.Errorf("getting snapshotsResp: %w", err)
+	}
+
+	snapshots := *snapshotsResp.Items
+	for i := range snapshots {
+		if snapshots[i].Id == nil {
+			continue
+		}
+		if utils.Contains(snapshotsToDestroy, *snapshots[i].Id) {
+			err := client.DeleteSnapshotExecute(ctx, testutil.ProjectId, *snapshots[i].Id)
+			if err != nil {
+				return fmt.Errorf("destroying snapshot %s during CheckDestroy: %w", *snapshots[i].Id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIaaSVolumeBackupDestroy(s *terraform.State) error {
+	ctx := context.Background()
+	var client *iaas.APIClient
+	var err error
+	if testutil.IaaSCustomEndpoint == "" {
+		client, err = iaas.NewAPIClient(
+			stackitSdkConfig.WithRegion("eu01"),
+		)
+	} else {
+		client, err = iaas.NewAPIClient(
+			stackitSdkConfig.WithEndpoint(testutil.IaaSCustomEndpoint),
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	backupsToDestroy := []string{}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "stackit_volume_backup" {
+			continue
+		}
+		// backup terraform ID: "[project_id],[backup_id]"
+		backupId := strings.Split(rs.Primary.ID, core.Separator)[1]
+		backupsToDestroy = append(backupsToDestroy, backupId)
+	}
+
+	backupsResp, err := client.ListBackupsExecute(ctx, testutil.ProjectId)
+	if err != nil {
+		return fmt.Errorf("getting backupsResp: %w", err)
+	}
+
+	backups := *backupsResp.Items
+	for i := range backups {
+		if backups[i].Id == nil {
+			continue
+		}
+		if utils.Contains(backupsToDestroy, *backups[i].Id) {
+			err := client.DeleteBackupExecute(ctx, testutil.ProjectId, *backups[i].Id)
+			if err != nil {
+				return fmt.Errorf("destroying backup %s during CheckDestroy: %w", *backups[i].Id, err)
+			}
+		}
+	}
+	return nil
+}
+
 func testAccCheckServerDestroy(s *terraform.State) error {
 	ctx := context.Background()
 	var alphaClient *iaas.APIClient
@@ -3,9 +3,14 @@ package image
 import (
 	"bufio"
 	"context"
+	"crypto/md5" //nolint:gosec // md5 is one of the checksum algorithms used by the API
+	"crypto/sha512"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -13,6 +18,8 @@ import (
 
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -42,6 +49,17 @@ var (
 	_ resource.ResourceWithImportState = &imageResource{}
 )
 
+const (
+	// uploadMaxAttempts is the number of times the image upload is attempted before failing
+	uploadMaxAttempts = 5
+	// uploadProgressLogStep is the upload progress in percent after which a progress log entry is written
+	uploadProgressLogStep = 10
+	// uploadProgressLogBytes is the amount of uploaded bytes after which a progress log entry is written, if the image size is unknown
+	uploadProgressLogBytes = 512 * 1024 * 1024
+	// uploadRetryWait is the time to wait before the first upload retry, it is doubled on every further retry
+	uploadRetryWait = 10 * time.Second
+)
+
 type Model struct {
 	Id            types.String `tfsdk:"id"` // needed by TF
 	ProjectId     types.String `tfsdk:"project_id"`
@@ -56,6 +74,7 @@ type Model struct {
 	Checksum      types.Object `tfsdk:"checksum"`
 	Labels        types.Map    `tfsdk:"labels"`
 	LocalFilePath types.String `tfsdk:"local_file_path"`
+	SourceURL     types.String `tfsdk:"source_url"`
 }
 
 // Struct corresponding to Model.Config
@@ -119,6 +138,16 @@ func (r *imageResource) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_image"
 }
 
+// ConfigValidators validates the resource configuration
+func (r *imageResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("local_file_path"),
+			path.MatchRoot("source_url"),
+		),
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *imageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -180,8 +209,8 @@ func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"local_file_path": schema.StringAttribute{
-				Description: "The filepath of the raw image file to be uploaded.",
-				Required:    true,
+				Description: "The filepath of the raw image file to be uploaded. Either `local_file_path` or `source_url` must be provided.",
+				Optional:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -191,6 +220,19 @@ func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					validate.FileExists(),
 				},
 			},
+			"source_url": schema.StringAttribute{
+				Description: "HTTP(S) URL of the raw image file. The image is streamed from this URL to STACKIT without being stored locally. The server must send the `Content-Length` of the file. Either `local_file_path` or `source_url` must be provided.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^https?://`),
+						"must be an HTTP or HTTPS URL",
+					),
+				},
+			},
 			"min_disk_size": schema.Int64Attribute{
 				Description: "The minimum disk size of the image in GB.",
 				Optional:    true,
@@ -336,7 +378,7 @@ func (r *imageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"checksum": schema.SingleNestedAttribute{
-				Description: "Representation of an image checksum.",
+				Description: "Representation of an image checksum. After the upload, the digest is verified against the checksum of the data that was uploaded. If the algorithm is not supported by the provider, only a warning is shown.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.Object{
 					objectplanmodifier.UseStateForUnknown(),
@@ -417,7 +459,7 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Upload image
-	err = uploadImage(ctx, &resp.Diagnostics, model.LocalFilePath.ValueString(), *imageCreateResp.UploadUrl)
+	uploadedChecksums, err := uploadImage(ctx, &resp.Diagnostics, model.LocalFilePath.ValueString(), model.SourceURL.ValueString(), *imageCreateResp.UploadUrl, uploadRetryWait)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Uploading image: %v", err))
 		return
@@ -432,6 +474,13 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Verify that the image data was not altered during the upload
+	err = verifyChecksum(ctx, &resp.Diagnostics, waitResp.Checksum, uploadedChecksums)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Verifying image checksum: %v", err))
+		return
+	}
+
 	// Map response body to schema
 	err = mapFields(ctx, waitResp, &model)
 	if err != nil {
@@ -775,45 +824,175 @@ func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map)
 	}, nil
 }
 
-func uploadImage(ctx context.Context, diags *diag.Diagnostics, filePath, uploadURL string) error {
-	if filePath == "" {
-		return fmt.Errorf("file path is empty")
+// uploadImage uploads the image from the local file or the source URL to the upload URL.
+// Failed uploads are retried from the start, as the upload URL doesn't support resuming partial uploads.
+// retryWait is the time to wait before the first retry, it is doubled on every further retry.
+// It returns the checksums of the uploaded data.
+func uploadImage(ctx context.Context, diags *diag.Diagnostics, filePath, sourceURL, uploadURL string, retryWait time.Duration) (map[string]string, error) {
+	if filePath == "" && sourceURL == "" {
+		return nil, fmt.Errorf("file path and source URL are empty")
 	}
 	if uploadURL == "" {
-		return fmt.Errorf("upload URL is empty")
+		return nil, fmt.Errorf("upload URL is empty")
 	}
 
-	file, err := os.Open(filePath)
-	if err != nil {
-		return fmt.Errorf("open file: %w", err)
+	client := &http.Client{}
+	wait := retryWait
+	var err error
+	for attempt := 1; attempt <= uploadMaxAttempts; attempt++ {
+		var checksums map[string]string
+		var retryable bool
+		checksums, retryable, err = uploadImageAttempt(ctx, diags, client, filePath, sourceURL, uploadURL)
+		if err == nil {
+			return checksums, nil
+		}
+		if !retryable || attempt == uploadMaxAttempts {
+			break
+		}
+		tflog.Warn(ctx, fmt.Sprintf("Image upload attempt %d of %d failed, retrying in %s: %v", attempt, uploadMaxAttempts, wait, err))
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("upload image: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+		wait *= 2
 	}
-	stat, err := file.Stat()
+	return nil, err
+}
+
+// uploadImageAttempt does a single upload of the image. The returned bool reports if a failed upload can be retried.
+func uploadImageAttempt(ctx context.Context, diags *diag.Diagnostics, client *http.Client, filePath, sourceURL, uploadURL string) (checksums map[string]string, retryable bool, err error) {
+	source, size, retryable, err := openImageSource(ctx, client, filePath, sourceURL)
 	if err != nil {
-		return fmt.Errorf("stat file: %w", err)
+		return nil, retryable, err
+	}
+	defer func() {
+		closeErr := source.Close()
+		if closeErr != nil {
+			core.LogAndAddError(ctx, diags, "Error uploading image", fmt.Sprintf("Closing image source: %v", closeErr))
+		}
+	}()
+
+	md5Hash := md5.New() //nolint:gosec // md5 is one of the checksum algorithms used by the API, not used for security
+	sha512Hash := sha512.New()
+	body := &progressReader{
+		ctx:    ctx,
+		reader: io.TeeReader(bufio.NewReader(source), io.MultiWriter(md5Hash, sha512Hash)),
+		size:   size,
 	}
 
-	req, err := http.NewRequest(http.MethodPut, uploadURL, bufio.NewReader(file))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, body)
 	if err != nil {
-		return fmt.Errorf("create upload request: %w", err)
+		return nil, false, fmt.Errorf("create upload request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/octet-stream")
-	req.ContentLength = stat.Size()
+	req.ContentLength = size
 
-	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("upload image: %w", err)
+		return nil, ctx.Err() == nil, fmt.Errorf("upload image: %w", err)
 	}
 	defer func() {
-		err = resp.Body.Close()
-		if err != nil {
-			core.LogAndAddError(ctx, diags, "Error uploading image", fmt.Sprintf("Closing response body: %v", err))
+		closeErr := resp.Body.Close()
+		if closeErr != nil {
+			core.LogAndAddError(ctx, diags, "Error uploading image", fmt.Sprintf("Closing response body: %v", closeErr))
 		}
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("upload image: %s", resp.Status)
+		return nil, isRetryableStatus(resp.StatusCode), fmt.Errorf("upload image: %s", resp.Status)
+	}
+	if body.read != size {
+		return nil, true, fmt.Errorf("upload image: uploaded %d of %d bytes", body.read, size)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Image upload finished, %d bytes uploaded", size))
+
+	return map[string]string{
+		"md5":    hex.EncodeToString(md5Hash.Sum(nil)),
+		"sha512": hex.EncodeToString(sha512Hash.Sum(nil)),
+	}, false, nil
+}
+
+// openImageSource opens the local image file or requests the image from the source URL.
+// It returns the image data, its size and, in case of an error, if opening the source can be retried.
+func openImageSource(ctx context.Context, client *http.Client, filePath, sourceURL string) (io.ReadCloser, int64, bool, error) {
+	if filePath != "" {
+		file, err := os.Open(filePath)
+		if err != nil {
+			return nil, 0, false, fmt.Errorf("open file: %w", err)
+		}
+		stat, err := file.Stat()
+		if err != nil {
+			_ = file.Close()
+			return nil, 0, false, fmt.Errorf("stat file: %w", err)
+		}
+		return file, stat.Size(), false, nil
 	}
 
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, http.NoBody)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("create source request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, ctx.Err() == nil, fmt.Errorf("download image from source URL: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, 0, isRetryableStatus(resp.StatusCode), fmt.Errorf("download image from source URL: %s", resp.Status)
+	}
+	// The upload URL doesn't accept chunked uploads, so the size must be known beforehand
+	if resp.ContentLength < 0 {
+		_ = resp.Body.Close()
+		return nil, 0, false, fmt.Errorf("download image from source URL: response has no Content-Length")
+	}
+	return resp.Body, resp.ContentLength, false, nil
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// verifyChecksum compares the checksum of the image reported by the API with the checksums of the uploaded data.
+// If the API uses an algorithm that isn't computed during the upload, a warning is added instead of an error,
+// since the image has already been uploaded successfully.
+func verifyChecksum(ctx context.Context, diags *diag.Diagnostics, imageChecksum *iaas.ImageChecksum, uploadedChecksums map[string]string) error {
+	if imageChecksum == nil || imageChecksum.Algorithm == nil || imageChecksum.Digest == nil {
+		return nil
+	}
+	algorithm := strings.ToLower(*imageChecksum.Algorithm)
+	uploadedDigest, ok := uploadedChecksums[algorithm]
+	if !ok {
+		core.LogAndAddWarning(ctx, diags, "Image checksum not verified", fmt.Sprintf("The checksum algorithm %q of the image is not supported, the uploaded data could not be verified.", *imageChecksum.Algorithm))
+		return nil
+	}
+	if !strings.EqualFold(uploadedDigest, *imageChecksum.Digest) {
+		return fmt.Errorf("%s digest of the image %q doesn't match the digest of the uploaded data %q", algorithm, *imageChecksum.Digest, uploadedDigest)
+	}
 	return nil
 }
+
+// progressReader logs the progress of the image upload
+type progressReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	size    int64
+	read    int64
+	lastLog int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.size > 0 {
+		percent := r.read * 100 / r.size
+		if percent-r.lastLog >= uploadProgressLogStep {
+			r.lastLog = percent
+			tflog.Info(r.ctx, fmt.Sprintf("Image upload progress: %d%% (%d of %d bytes)", percent, r.read, r.size))
+		}
+	} else if r.read-r.lastLog >= uploadProgressLogBytes {
+		r.lastLog = r.read
+		tflog.Info(r.ctx, fmt.Sprintf("Image upload progress: %d bytes", r.read))
+	}
+	return n, err
+}
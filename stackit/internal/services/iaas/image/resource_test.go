@@ -1,11 +1,16 @@
 package image
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha512"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -336,39 +341,92 @@ func TestToUpdatePayload(t *testing.T) {
 
 func Test_UploadImage(t *testing.T) {
 	tests := []struct {
-		name        string
-		filePath    string
-		uploadFails bool
-		wantErr     bool
+		name           string
+		filePath       string
+		sourceStatus   int
+		uploadStatuses []int
+		wantAttempts   int
+		wantErr        bool
 	}{
 		{
-			name:        "ok",
-			filePath:    "testdata/mock-image.txt",
-			uploadFails: false,
-			wantErr:     false,
+			name:           "ok",
+			filePath:       "testdata/mock-image.txt",
+			uploadStatuses: []int{http.StatusOK},
+			wantAttempts:   1,
+			wantErr:        false,
 		},
 		{
-			name:        "upload_fails",
-			filePath:    "testdata/mock-image.txt",
-			uploadFails: true,
-			wantErr:     true,
+			name:           "upload_fails",
+			filePath:       "testdata/mock-image.txt",
+			uploadStatuses: []int{http.StatusInternalServerError},
+			wantAttempts:   uploadMaxAttempts,
+			wantErr:        true,
 		},
 		{
-			name:        "file_not_found",
-			filePath:    "testdata/non-existing-file.txt",
-			uploadFails: false,
-			wantErr:     true,
+			name:           "upload_retried",
+			filePath:       "testdata/mock-image.txt",
+			uploadStatuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			wantAttempts:   3,
+			wantErr:        false,
+		},
+		{
+			name:           "upload_not_retried_on_client_error",
+			filePath:       "testdata/mock-image.txt",
+			uploadStatuses: []int{http.StatusForbidden},
+			wantAttempts:   1,
+			wantErr:        true,
+		},
+		{
+			name:           "file_not_found",
+			filePath:       "testdata/non-existing-file.txt",
+			uploadStatuses: []int{http.StatusOK},
+			wantAttempts:   0,
+			wantErr:        true,
+		},
+		{
+			name:           "source_url_ok",
+			sourceStatus:   http.StatusOK,
+			uploadStatuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts:   2,
+			wantErr:        false,
+		},
+		{
+			name:           "source_url_not_found",
+			sourceStatus:   http.StatusNotFound,
+			uploadStatuses: []int{http.StatusOK},
+			wantAttempts:   0,
+			wantErr:        true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Setup a test server
-			handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-				if tt.uploadFails {
-					w.WriteHeader(http.StatusInternalServerError)
+			imageData, err := os.ReadFile("testdata/mock-image.txt")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Setup a test server serving the image and accepting uploads
+			attempts := 0
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					w.WriteHeader(tt.sourceStatus)
+					if tt.sourceStatus == http.StatusOK {
+						_, _ = w.Write(imageData)
+					}
+					return
+				}
+				body, _ := io.ReadAll(r.Body)
+				status := tt.uploadStatuses[min(attempts, len(tt.uploadStatuses)-1)]
+				attempts++
+				if status != http.StatusOK {
+					w.WriteHeader(status)
 					_, _ = fmt.Fprintln(w, `{"status":"some error occurred"}`)
 					return
 				}
+				if !bytes.Equal(body, imageData) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(http.StatusOK)
 				_, _ = fmt.Fprintln(w, `{"status":"ok"}`)
@@ -380,12 +438,97 @@ func Test_UploadImage(t *testing.T) {
 				t.Error(err)
 				return
 			}
+			var sourceURL string
+			if tt.filePath == "" {
+				sourceURL = server.URL + "/image"
+			}
 
 			// Call the function
-			err = uploadImage(context.Background(), &diag.Diagnostics{}, tt.filePath, uploadURL.String())
+			checksums, err := uploadImage(context.Background(), &diag.Diagnostics{}, tt.filePath, sourceURL, uploadURL.String(), 0)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("uploadImage() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("uploadImage() attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if !tt.wantErr {
+				sha512Sum := sha512.Sum512(imageData)
+				diff := cmp.Diff(checksums["sha512"], hex.EncodeToString(sha512Sum[:]))
+				if diff != "" {
+					t.Errorf("Checksum does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	uploadedChecksums := map[string]string{
+		"md5":    "abc123",
+		"sha512": "def456",
+	}
+	tests := []struct {
+		description string
+		input       *iaas.ImageChecksum
+		isValid     bool
+		hasWarning  bool
+	}{
+		{
+			"sha512_match",
+			&iaas.ImageChecksum{
+				Algorithm: utils.Ptr("sha512"),
+				Digest:    utils.Ptr("def456"),
+			},
+			true,
+			false,
+		},
+		{
+			"md5_match_case_insensitive",
+			&iaas.ImageChecksum{
+				Algorithm: utils.Ptr("MD5"),
+				Digest:    utils.Ptr("ABC123"),
+			},
+			true,
+			false,
+		},
+		{
+			"digest_mismatch",
+			&iaas.ImageChecksum{
+				Algorithm: utils.Ptr("sha512"),
+				Digest:    utils.Ptr("abc123"),
+			},
+			false,
+			false,
+		},
+		{
+			"unsupported_algorithm",
+			&iaas.ImageChecksum{
+				Algorithm: utils.Ptr("sha1"),
+				Digest:    utils.Ptr("abc123"),
+			},
+			true,
+			true,
+		},
+		{
+			"no_checksum",
+			nil,
+			true,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			err := verifyChecksum(context.Background(), &diags, tt.input, uploadedChecksums)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if diags.WarningsCount() > 0 != tt.hasWarning {
+				t.Fatalf("Expected warning: %t, got warnings: %v", tt.hasWarning, diags.Warnings())
+			}
 		})
 	}
 }
@@ -0,0 +1,374 @@
+package imageshare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &imageShareResource{}
+	_ resource.ResourceWithConfigure        = &imageShareResource{}
+	_ resource.ResourceWithImportState      = &imageShareResource{}
+	_ resource.ResourceWithConfigValidators = &imageShareResource{}
+)
+
+type Model struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	ProjectId          types.String `tfsdk:"project_id"`
+	ImageId            types.String `tfsdk:"image_id"`
+	ParentOrganization types.Bool   `tfsdk:"parent_organization"`
+	Projects           types.List   `tfsdk:"projects"`
+}
+
+// NewImageShareResource is a helper function to simplify the provider implementation.
+func NewImageShareResource() resource.Resource {
+	return &imageShareResource{}
+}
+
+// imageShareResource is the resource implementation.
+type imageShareResource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *imageShareResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_share"
+}
+
+// ConfigValidators validates the resource configuration
+func (r *imageShareResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("parent_organization"),
+			path.MatchRoot("projects"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imageShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *imageShareResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Image share resource schema. Manages with which projects an image is shared. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description + "\n\n" +
+			"~> This resource manages the complete share settings of the image. Do not use it together with `stackit_image_share_consumer` resources for the same image, as they would overwrite each other's changes.",
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`image_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID that owns the image.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"image_id": schema.StringAttribute{
+				Description: "The ID of the image to share.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"parent_organization": schema.BoolAttribute{
+				Description: "If set to `true`, the image is shared with all projects inside the organization of the image owner. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"projects": schema.ListAttribute{
+				Description: "List of IDs of the projects the image is shared with.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						validate.UUID(),
+						validate.NoSeparator(),
+					),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *imageShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	imageId := model.ImageId.ValueString()
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+
+	// Generate API request body from model
+	payload, err := toPayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image share", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	// Share image
+	imageShareResp, err := r.client.SetImageShare(ctx, projectId, imageId).SetImageSharePayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image share", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, imageShareResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image share", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Image share created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *imageShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	imageId := model.ImageId.ValueString()
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+
+	imageShareResp, err := r.client.GetImageShare(ctx, projectId, imageId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading image share", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, imageShareResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading image share", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Image share read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *imageShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	imageId := model.ImageId.ValueString()
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+
+	// Generate API request body from model
+	payload, err := toPayload(&model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating image share", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	// The share settings are replaced as a whole
+	imageShareResp, err := r.client.SetImageShare(ctx, projectId, imageId).SetImageSharePayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating image share", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, imageShareResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating image share", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Image share updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *imageShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	imageId := model.ImageId.ValueString()
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+
+	// Remove image share, the image scope becomes local again
+	err := r.client.DeleteImageShare(ctx, projectId, imageId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Image share already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting image share", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Image share deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,image_id
+func (r *imageShareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing image share",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[image_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	projectId := idParts[0]
+	imageId := idParts[1]
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("image_id"), imageId)...)
+	tflog.Info(ctx, "Image share state imported")
+}
+
+func mapFields(ctx context.Context, imageShareResp *iaas.ImageShare, model *Model) error {
+	if imageShareResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.ImageId.ValueString())
+	model.ParentOrganization = types.BoolValue(imageShareResp.GetParentOrganization())
+
+	respProjects := imageShareResp.GetProjects()
+	if len(respProjects) == 0 && model.Projects.IsNull() {
+		model.Projects = types.ListNull(types.StringType)
+		return nil
+	}
+
+	modelProjects, err := utils.ListValuetoStringSlice(model.Projects)
+	if err != nil {
+		return fmt.Errorf("get current projects from model: %w", err)
+	}
+	reconciledProjects := utils.ReconcileStringSlices(modelProjects, respProjects)
+
+	projectsTF, diags := types.ListValueFrom(ctx, types.StringType, reconciledProjects)
+	if diags.HasError() {
+		return fmt.Errorf("map projects: %w", core.DiagsToError(diags))
+	}
+	model.Projects = projectsTF
+	return nil
+}
+
+func toPayload(model *Model) (*iaas.SetImageSharePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	projects := []string{}
+	if !model.Projects.IsNull() && !model.Projects.IsUnknown() {
+		var err error
+		projects, err = utils.ListValuetoStringSlice(model.Projects)
+		if err != nil {
+			return nil, fmt.Errorf("converting projects: %w", err)
+		}
+	}
+
+	return &iaas.SetImageSharePayload{
+		ParentOrganization: conversion.BoolValueToPointer(model.ParentOrganization),
+		Projects:           &projects,
+	}, nil
+}
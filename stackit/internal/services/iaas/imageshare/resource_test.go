@@ -0,0 +1,189 @@
+package imageshare
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaas.ImageShare
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				ImageId:   types.StringValue("iid"),
+			},
+			&iaas.ImageShare{},
+			Model{
+				Id:                 types.StringValue("pid,iid"),
+				ProjectId:          types.StringValue("pid"),
+				ImageId:            types.StringValue("iid"),
+				ParentOrganization: types.BoolValue(false),
+				Projects:           types.ListNull(types.StringType),
+			},
+			true,
+		},
+		{
+			"parent_organization",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				ImageId:   types.StringValue("iid"),
+			},
+			&iaas.ImageShare{
+				ParentOrganization: utils.Ptr(true),
+				Projects:           &[]string{},
+			},
+			Model{
+				Id:                 types.StringValue("pid,iid"),
+				ProjectId:          types.StringValue("pid"),
+				ImageId:            types.StringValue("iid"),
+				ParentOrganization: types.BoolValue(true),
+				Projects:           types.ListNull(types.StringType),
+			},
+			true,
+		},
+		{
+			"projects_keep_model_order",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				ImageId:   types.StringValue("iid"),
+				Projects: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("pid-2"),
+					types.StringValue("pid-1"),
+					types.StringValue("pid-3"),
+				}),
+			},
+			&iaas.ImageShare{
+				ParentOrganization: utils.Ptr(false),
+				Projects:           &[]string{"pid-1", "pid-2", "pid-4"},
+			},
+			Model{
+				Id:                 types.StringValue("pid,iid"),
+				ProjectId:          types.StringValue("pid"),
+				ImageId:            types.StringValue("iid"),
+				ParentOrganization: types.BoolValue(false),
+				Projects: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("pid-2"),
+					types.StringValue("pid-1"),
+					types.StringValue("pid-4"),
+				}),
+			},
+			true,
+		},
+		{
+			"projects_removed_outside_terraform",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				ImageId:   types.StringValue("iid"),
+				Projects: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("pid-1"),
+				}),
+			},
+			&iaas.ImageShare{},
+			Model{
+				Id:                 types.StringValue("pid,iid"),
+				ProjectId:          types.StringValue("pid"),
+				ImageId:            types.StringValue("iid"),
+				ParentOrganization: types.BoolValue(false),
+				Projects:           types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *iaas.SetImageSharePayload
+		isValid     bool
+	}{
+		{
+			"parent_organization",
+			&Model{
+				ParentOrganization: types.BoolValue(true),
+				Projects:           types.ListNull(types.StringType),
+			},
+			&iaas.SetImageSharePayload{
+				ParentOrganization: utils.Ptr(true),
+				Projects:           &[]string{},
+			},
+			true,
+		},
+		{
+			"projects",
+			&Model{
+				ParentOrganization: types.BoolValue(false),
+				Projects: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("pid-1"),
+					types.StringValue("pid-2"),
+				}),
+			},
+			&iaas.SetImageSharePayload{
+				ParentOrganization: utils.Ptr(false),
+				Projects:           &[]string{"pid-1", "pid-2"},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toPayload(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
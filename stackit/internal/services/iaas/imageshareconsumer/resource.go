@@ -0,0 +1,325 @@
+package imageshareconsumer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &imageShareConsumerResource{}
+	_ resource.ResourceWithConfigure   = &imageShareConsumerResource{}
+	_ resource.ResourceWithImportState = &imageShareConsumerResource{}
+)
+
+type Model struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	ProjectId         types.String `tfsdk:"project_id"`
+	ImageId           types.String `tfsdk:"image_id"`
+	ConsumerProjectId types.String `tfsdk:"consumer_project_id"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+}
+
+// NewImageShareConsumerResource is a helper function to simplify the provider implementation.
+func NewImageShareConsumerResource() resource.Resource {
+	return &imageShareConsumerResource{}
+}
+
+// imageShareConsumerResource is the resource implementation.
+type imageShareConsumerResource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *imageShareConsumerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_share_consumer"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *imageShareConsumerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *imageShareConsumerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Image share consumer resource schema. Shares an image with a single consumer project, leaving other consumers of the image untouched. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description + "\n\n" +
+			"-> The STACKIT API has no acceptance step for shared images: once created, the image is available in the consumer project. The resource must be managed with credentials of the project owning the image.\n\n" +
+			"~> Do not use this resource together with a `stackit_image_share` resource for the same image, as they would overwrite each other's changes.",
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`image_id`,`consumer_project_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID that owns the image.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"image_id": schema.StringAttribute{
+				Description: "The ID of the shared image.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"consumer_project_id": schema.StringAttribute{
+				Description: "The ID of the project the image is shared with.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date-time when the image was shared with the consumer project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *imageShareConsumerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	imageId := model.ImageId.ValueString()
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+	consumerProjectId := model.ConsumerProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "consumer_project_id", consumerProjectId)
+
+	// Append the consumer project to the projects the image is shared with
+	_, err := r.client.UpdateImageShare(ctx, projectId, imageId).UpdateImageSharePayload(*toUpdatePayload(consumerProjectId)).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image share consumer", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	consumerResp, err := r.client.GetImageShareConsumer(ctx, projectId, imageId, consumerProjectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image share consumer", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(consumerResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image share consumer", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Image share consumer created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *imageShareConsumerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	imageId := model.ImageId.ValueString()
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+	consumerProjectId := model.ConsumerProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "consumer_project_id", consumerProjectId)
+
+	consumerResp, err := r.client.GetImageShareConsumer(ctx, projectId, imageId, consumerProjectId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading image share consumer", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(consumerResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading image share consumer", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Image share consumer read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *imageShareConsumerResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Update is not supported, all fields require replace
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *imageShareConsumerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	imageId := model.ImageId.ValueString()
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+	consumerProjectId := model.ConsumerProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "consumer_project_id", consumerProjectId)
+
+	// Remove consumer project from image share
+	err := r.client.DeleteImageShareConsumer(ctx, projectId, imageId, consumerProjectId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Image share consumer already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting image share consumer", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Image share consumer deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,image_id,consumer_project_id
+func (r *imageShareConsumerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing image share consumer",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[image_id],[consumer_project_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	projectId := idParts[0]
+	imageId := idParts[1]
+	consumerProjectId := idParts[2]
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "image_id", imageId)
+	ctx = tflog.SetField(ctx, "consumer_project_id", consumerProjectId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("image_id"), imageId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("consumer_project_id"), consumerProjectId)...)
+	tflog.Info(ctx, "Image share consumer state imported")
+}
+
+func mapFields(consumerResp *iaas.ImageShareConsumer, model *Model) error {
+	if consumerResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var imageId string
+	if model.ImageId.ValueString() != "" {
+		imageId = model.ImageId.ValueString()
+	} else if consumerResp.ImageId != nil {
+		imageId = *consumerResp.ImageId
+	} else {
+		return fmt.Errorf("image id not present")
+	}
+
+	var consumerProjectId string
+	if model.ConsumerProjectId.ValueString() != "" {
+		consumerProjectId = model.ConsumerProjectId.ValueString()
+	} else if consumerResp.ConsumerProjectId != nil {
+		consumerProjectId = *consumerResp.ConsumerProjectId
+	} else {
+		return fmt.Errorf("consumer project id not present")
+	}
+
+	createdAt := types.StringNull()
+	if consumerResp.CreatedAt != nil {
+		createdAt = types.StringValue(consumerResp.CreatedAt.Format(time.RFC3339))
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), imageId, consumerProjectId)
+	model.ImageId = types.StringValue(imageId)
+	model.ConsumerProjectId = types.StringValue(consumerProjectId)
+	model.CreatedAt = createdAt
+	return nil
+}
+
+func toUpdatePayload(consumerProjectId string) *iaas.UpdateImageSharePayload {
+	return &iaas.UpdateImageSharePayload{
+		Projects: &[]string{consumerProjectId},
+	}
+}
@@ -0,0 +1,113 @@
+package imageshareconsumer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaas.ImageShareConsumer
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			Model{
+				ProjectId:         types.StringValue("pid"),
+				ImageId:           types.StringValue("iid"),
+				ConsumerProjectId: types.StringValue("cpid"),
+			},
+			&iaas.ImageShareConsumer{},
+			Model{
+				Id:                types.StringValue("pid,iid,cpid"),
+				ProjectId:         types.StringValue("pid"),
+				ImageId:           types.StringValue("iid"),
+				ConsumerProjectId: types.StringValue("cpid"),
+				CreatedAt:         types.StringNull(),
+			},
+			true,
+		},
+		{
+			"values_from_response",
+			Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.ImageShareConsumer{
+				ImageId:           utils.Ptr("iid"),
+				ConsumerProjectId: utils.Ptr("cpid"),
+				CreatedAt:         utils.Ptr(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			Model{
+				Id:                types.StringValue("pid,iid,cpid"),
+				ProjectId:         types.StringValue("pid"),
+				ImageId:           types.StringValue("iid"),
+				ConsumerProjectId: types.StringValue("cpid"),
+				CreatedAt:         types.StringValue("2025-01-02T03:04:05Z"),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_image_id",
+			Model{
+				ProjectId:         types.StringValue("pid"),
+				ConsumerProjectId: types.StringValue("cpid"),
+			},
+			&iaas.ImageShareConsumer{},
+			Model{},
+			false,
+		},
+		{
+			"no_consumer_project_id",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				ImageId:   types.StringValue("iid"),
+			},
+			&iaas.ImageShareConsumer{},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	output := toUpdatePayload("cpid")
+	expected := &iaas.UpdateImageSharePayload{
+		Projects: &[]string{"cpid"},
+	}
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"regexp"
 	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,7 +31,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource = &imageDataV2Source{}
+	_ datasource.DataSource                   = &imageDataV2Source{}
+	_ datasource.DataSourceWithValidateConfig = &imageDataV2Source{}
 )
 
 type DataSourceModel struct {
@@ -40,6 +42,8 @@ type DataSourceModel struct {
 	Name          types.String `tfsdk:"name"`
 	NameRegex     types.String `tfsdk:"name_regex"`
 	SortAscending types.Bool   `tfsdk:"sort_ascending"`
+	MostRecent    types.Bool   `tfsdk:"most_recent"`
+	PinUntil      types.String `tfsdk:"pin_until"`
 	Filter        types.Object `tfsdk:"filter"`
 
 	DiskFormat  types.String `tfsdk:"disk_format"`
@@ -50,14 +54,7 @@ type DataSourceModel struct {
 	Config      types.Object `tfsdk:"config"`
 	Checksum    types.Object `tfsdk:"checksum"`
 	Labels      types.Map    `tfsdk:"labels"`
-}
-
-type Filter struct {
-	OS         types.String `tfsdk:"os"`
-	Distro     types.String `tfsdk:"distro"`
-	Version    types.String `tfsdk:"version"`
-	UEFI       types.Bool   `tfsdk:"uefi"`
-	SecureBoot types.Bool   `tfsdk:"secure_boot"`
+	CreatedAt   types.String `tfsdk:"created_at"`
 }
 
 // Struct corresponding to Model.Config
@@ -146,7 +143,6 @@ func (d *imageDataV2Source) ConfigValidators(_ context.Context) []datasource.Con
 		datasourcevalidator.Conflicting(
 			path.MatchRoot("name"),
 			path.MatchRoot("name_regex"),
-			path.MatchRoot("image_id"),
 		),
 		datasourcevalidator.AtLeastOneOf(
 			path.MatchRoot("name"),
@@ -157,6 +153,29 @@ func (d *imageDataV2Source) ConfigValidators(_ context.Context) []datasource.Con
 	}
 }
 
+// ValidateConfig validates the combination of a static image_id with the dynamic image selection.
+func (d *imageDataV2Source) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var model DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasImageId := !model.ImageId.IsNull()
+	hasSelection := !model.Name.IsNull() || !model.NameRegex.IsNull() || !model.Filter.IsNull()
+	if model.PinUntil.IsNull() {
+		if hasImageId && hasSelection {
+			resp.Diagnostics.AddAttributeError(path.Root("image_id"), "Invalid image selection",
+				"`image_id` can only be combined with `name`, `name_regex` or `filter` if `pin_until` is set.")
+		}
+		return
+	}
+	if !hasImageId || !hasSelection {
+		resp.Diagnostics.AddAttributeError(path.Root("pin_until"), "Invalid image pin",
+			"`pin_until` requires `image_id` and at least one of `name`, `name_regex` or `filter` to select the image once the pin has expired.")
+	}
+}
+
 // Schema defines the schema for the datasource.
 func (d *imageDataV2Source) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	description := features.AddBetaDescription(fmt.Sprintf(
@@ -165,6 +184,7 @@ func (d *imageDataV2Source) Schema(_ context.Context, _ datasource.SchemaRequest
 		"Important: When using the `name`, `name_regex`, or `filter` attributes to select images dynamically, be aware that image IDs may change frequently. Each OS patch or update results in a new unique image ID. If this data source is used to populate fields like `boot_volume.source_id` in a server resource, it may cause Terraform to detect changes and recreate the associated resource.\n\n"+
 			"To avoid unintended updates or resource replacements:\n"+
 			" - Prefer using a static `image_id` to pin a specific image version.\n"+
+			" - Combine `image_id` with `pin_until` and `name`, `name_regex` or `filter`: the pinned `image_id` is returned until the given date, afterwards the image is selected dynamically. Update `image_id` and `pin_until` in your next maintenance window to move the pin forward.\n"+
 			" - If you accept automatic image updates but wish to suppress resource changes, use a `lifecycle` block to ignore relevant changes. For example:\n\n"+
 			"```hcl\n"+
 			"resource \"stackit_server\" \"example\" {\n"+
@@ -201,7 +221,7 @@ func (d *imageDataV2Source) Schema(_ context.Context, _ datasource.SchemaRequest
 				},
 			},
 			"image_id": schema.StringAttribute{
-				Description: "Image ID to fetch directly",
+				Description: "Image ID to fetch directly. Can only be combined with `name`, `name_regex` or `filter` if `pin_until` is set.",
 				Optional:    true,
 				Validators: []validator.String{
 					validate.UUID(),
@@ -220,32 +240,18 @@ func (d *imageDataV2Source) Schema(_ context.Context, _ datasource.SchemaRequest
 				Description: "If set to `true`, images are sorted in ascending lexicographical order by image name (such as `Ubuntu 18.04`, `Ubuntu 20.04`, `Ubuntu 22.04`) before selecting the first match. Defaults to `false` (descending such as `Ubuntu 22.04`, `Ubuntu 20.04`, `Ubuntu 18.04`).",
 				Optional:    true,
 			},
-			"filter": schema.SingleNestedAttribute{
+			"most_recent": schema.BoolAttribute{
+				Description: "If set to `true`, the most recently created image is selected. Images created at the same time are ordered by `sort_ascending`.",
 				Optional:    true,
-				Description: "Additional filtering options based on image properties. Can be used independently or in conjunction with `name` or `name_regex`.",
-				Attributes: map[string]schema.Attribute{
-					"os": schema.StringAttribute{
-						Optional:    true,
-						Description: "Filter images by operating system type, such as `linux` or `windows`.",
-					},
-					"distro": schema.StringAttribute{
-						Optional:    true,
-						Description: "Filter images by operating system distribution. For example: `ubuntu`, `ubuntu-arm64`, `debian`, `rhel`, etc.",
-					},
-					"version": schema.StringAttribute{
-						Optional:    true,
-						Description: "Filter images by OS distribution version, such as `22.04`, `11`, or `9.1`.",
-					},
-					"uefi": schema.BoolAttribute{
-						Optional:    true,
-						Description: "Filter images based on UEFI support. Set to `true` to match images that support UEFI.",
-					},
-					"secure_boot": schema.BoolAttribute{
-						Optional:    true,
-						Description: "Filter images with Secure Boot support. Set to `true` to match images that support Secure Boot.",
-					},
+			},
+			"pin_until": schema.StringAttribute{
+				Description: "RFC3339 timestamp, e.g. `2025-12-31T00:00:00Z`. Until this date, the image given by `image_id` is returned. Afterwards, the image is selected by `name`, `name_regex` and `filter`. Data sources have no access to previously read values, so the pinned image must be given as `image_id`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.RFC3339SecondsOnly(),
 				},
 			},
+			"filter": filterAttribute(),
 			"disk_format": schema.StringAttribute{
 				Description: "The disk format of the image.",
 				Computed:    true,
@@ -343,6 +349,10 @@ func (d *imageDataV2Source) Schema(_ context.Context, _ datasource.SchemaRequest
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"created_at": schema.StringAttribute{
+				Description: "Date-time when the image was created.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -361,6 +371,7 @@ func (d *imageDataV2Source) Read(ctx context.Context, req datasource.ReadRequest
 	name := model.Name.ValueString()
 	nameRegex := model.NameRegex.ValueString()
 	sortAscending := model.SortAscending.ValueBool()
+	mostRecent := model.MostRecent.ValueBool()
 
 	var filter Filter
 	if !model.Filter.IsNull() && !model.Filter.IsUnknown() {
@@ -375,6 +386,22 @@ func (d *imageDataV2Source) Read(ctx context.Context, req datasource.ReadRequest
 	ctx = tflog.SetField(ctx, "name", name)
 	ctx = tflog.SetField(ctx, "name_regex", nameRegex)
 	ctx = tflog.SetField(ctx, "sort_ascending", sortAscending)
+	ctx = tflog.SetField(ctx, "most_recent", mostRecent)
+
+	// An image_id with pin_until is only used until the pin expires, afterwards the image is selected dynamically
+	if imageID != "" && !model.PinUntil.IsNull() {
+		pinned, err := isPinned(model.PinUntil, time.Now())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading image", err.Error())
+			return
+		}
+		if !pinned {
+			core.LogAndAddWarning(ctx, &resp.Diagnostics, "Image pin expired",
+				fmt.Sprintf("The pin of image %q expired at %s, the image is selected using name, name_regex and filter criteria.", imageID, model.PinUntil.ValueString()))
+			imageID = ""
+			model.ImageId = types.StringNull()
+		}
+	}
 
 	var imageResp *iaas.Image
 	var err error
@@ -411,34 +438,8 @@ func (d *imageDataV2Source) Read(ctx context.Context, req datasource.ReadRequest
 			return
 		}
 
-		// Step 1: Match images by name or regular expression (name or name_regex, if provided)
-		var matchedImages []*iaas.Image
-		for i := range *imageList.Items {
-			img := &(*imageList.Items)[i]
-			if name != "" && img.Name != nil && *img.Name == name {
-				matchedImages = append(matchedImages, img)
-			}
-			if compiledRegex != nil && img.Name != nil && compiledRegex.MatchString(*img.Name) {
-				matchedImages = append(matchedImages, img)
-			}
-			// If neither name nor name_regex is specified, include all images for filter evaluation later
-			if name == "" && nameRegex == "" {
-				matchedImages = append(matchedImages, img)
-			}
-		}
-
-		// Step 2: Sort matched images by name (optional, based on sortAscending flag)
-		if len(matchedImages) > 1 {
-			sortImagesByName(matchedImages, sortAscending)
-		}
-
-		// Step 3: Apply additional filtering based on OS, distro, version, UEFI, secure boot, etc.
-		var filteredImages []*iaas.Image
-		for _, img := range matchedImages {
-			if imageMatchesFilter(img, &filter) {
-				filteredImages = append(filteredImages, img)
-			}
-		}
+		// Match images by name or name_regex and the filter criteria, sorted by name or creation date
+		filteredImages := selectImages(imageList.GetItems(), projectID, name, compiledRegex, &filter, sortAscending, mostRecent)
 
 		// Check if any images passed all filters; warn if no matching image was found
 		if len(filteredImages) == 0 {
@@ -447,7 +448,7 @@ func (d *imageDataV2Source) Read(ctx context.Context, req datasource.ReadRequest
 			return
 		}
 
-		// Step 4: Use the first image from the filtered and sorted result list
+		// Use the first image from the filtered and sorted result list
 		imageResp = filteredImages[0]
 	}
 
@@ -550,6 +551,11 @@ func mapDataSourceFields(ctx context.Context, imageResp *iaas.Image, model *Data
 		return err
 	}
 
+	createdAt := types.StringNull()
+	if imageResp.CreatedAt != nil {
+		createdAt = types.StringValue(imageResp.CreatedAt.Format(time.RFC3339))
+	}
+
 	model.ImageId = types.StringValue(imageId)
 	model.Name = types.StringPointerValue(imageResp.Name)
 	model.DiskFormat = types.StringPointerValue(imageResp.DiskFormat)
@@ -558,6 +564,7 @@ func mapDataSourceFields(ctx context.Context, imageResp *iaas.Image, model *Data
 	model.Protected = types.BoolPointerValue(imageResp.Protected)
 	model.Scope = types.StringPointerValue(imageResp.Scope)
 	model.Labels = labels
+	model.CreatedAt = createdAt
 	model.Config = configObject
 	model.Checksum = checksumObject
 	return nil
@@ -603,6 +610,11 @@ func imageMatchesFilter(img *iaas.Image, filter *Filter) bool {
 		return false
 	}
 
+	if !filter.Architecture.IsNull() &&
+		(cfg.Architecture == nil || filter.Architecture.ValueString() != *cfg.Architecture) {
+		return false
+	}
+
 	return true
 }
 
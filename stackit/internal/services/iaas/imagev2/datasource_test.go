@@ -3,6 +3,7 @@ package image
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -50,6 +51,7 @@ func TestMapDataSourceFields(t *testing.T) {
 				MinRam:      utils.Ptr(int64(1)),
 				Protected:   utils.Ptr(true),
 				Scope:       utils.Ptr("scope"),
+				CreatedAt:   utils.Ptr(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
 				Config: &iaas.ImageConfig{
 					BootMenu:               utils.Ptr(true),
 					CdromBus:               iaas.NewNullableString(utils.Ptr("cdrom_bus")),
@@ -83,6 +85,7 @@ func TestMapDataSourceFields(t *testing.T) {
 				MinRAM:      types.Int64Value(1),
 				Protected:   types.BoolValue(true),
 				Scope:       types.StringValue("scope"),
+				CreatedAt:   types.StringValue("2025-01-02T03:04:05Z"),
 				Config: types.ObjectValueMust(configTypes, map[string]attr.Value{
 					"boot_menu":                types.BoolValue(true),
 					"cdrom_bus":                types.StringValue("cdrom_bus"),
@@ -261,6 +264,30 @@ func TestImageMatchesFilter(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "Architecture mismatch",
+			img: &iaas.Image{
+				Config: &iaas.ImageConfig{
+					Architecture: utils.Ptr("x86"),
+				},
+			},
+			filter: &Filter{
+				Architecture: types.StringValue("arm64"),
+			},
+			expected: false,
+		},
+		{
+			name: "Architecture match",
+			img: &iaas.Image{
+				Config: &iaas.ImageConfig{
+					Architecture: utils.Ptr("arm64"),
+				},
+			},
+			filter: &Filter{
+				Architecture: types.StringValue("arm64"),
+			},
+			expected: true,
+		},
 		{
 			name: "SecureBoot match - true",
 			img: &iaas.Image{
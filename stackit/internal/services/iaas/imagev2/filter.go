@@ -0,0 +1,178 @@
+package image
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+const (
+	ownerScopePublic  = "public"
+	ownerScopePrivate = "private"
+	ownerScopeShared  = "shared"
+
+	// imageScopePublic is the scope the API returns for images provided by STACKIT
+	imageScopePublic = "public"
+)
+
+var ownerScopeOptions = []string{ownerScopePublic, ownerScopePrivate, ownerScopeShared}
+
+type Filter struct {
+	OS           types.String `tfsdk:"os"`
+	Distro       types.String `tfsdk:"distro"`
+	Version      types.String `tfsdk:"version"`
+	UEFI         types.Bool   `tfsdk:"uefi"`
+	SecureBoot   types.Bool   `tfsdk:"secure_boot"`
+	Architecture types.String `tfsdk:"architecture"`
+	Labels       types.Map    `tfsdk:"labels"`
+	OwnerScope   types.String `tfsdk:"owner_scope"`
+}
+
+// filterAttribute returns the schema of the filter block shared by the image data sources.
+func filterAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Additional filtering options based on image properties. Can be used independently or in conjunction with `name` or `name_regex`.",
+		Attributes: map[string]schema.Attribute{
+			"os": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter images by operating system type, such as `linux` or `windows`.",
+			},
+			"distro": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter images by operating system distribution. For example: `ubuntu`, `ubuntu-arm64`, `debian`, `rhel`, etc.",
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter images by OS distribution version, such as `22.04`, `11`, or `9.1`.",
+			},
+			"uefi": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Filter images based on UEFI support. Set to `true` to match images that support UEFI.",
+			},
+			"secure_boot": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Filter images with Secure Boot support. Set to `true` to match images that support Secure Boot.",
+			},
+			"architecture": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter images by CPU architecture, such as `x86` or `arm64`.",
+			},
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Filter images by labels. Only images having all given labels with exactly the given values are matched.",
+			},
+			"owner_scope": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("Filter images by owner. `%s` matches images provided by STACKIT, `%s` matches images owned by the project and `%s` matches images shared with the project by others. %s",
+					ownerScopePublic, ownerScopePrivate, ownerScopeShared, utils.SupportedValuesDocumentation(ownerScopeOptions)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ownerScopeOptions...),
+				},
+			},
+		},
+	}
+}
+
+// imageMatchesLabels checks whether the image has all labels of the filter with the given values.
+func imageMatchesLabels(img *iaas.Image, filter *Filter) bool {
+	if filter == nil || filter.Labels.IsNull() || filter.Labels.IsUnknown() {
+		return true
+	}
+
+	imageLabels := img.GetLabels()
+	for key, value := range filter.Labels.Elements() {
+		expected, ok := value.(types.String)
+		if !ok {
+			return false
+		}
+		actual, ok := imageLabels[key]
+		if !ok || fmt.Sprint(actual) != expected.ValueString() {
+			return false
+		}
+	}
+	return true
+}
+
+// imageMatchesOwnerScope checks whether the image is public, owned by the project or shared with it, as requested by the filter.
+func imageMatchesOwnerScope(img *iaas.Image, filter *Filter, projectId string) bool {
+	if filter == nil || filter.OwnerScope.IsNull() || filter.OwnerScope.IsUnknown() {
+		return true
+	}
+
+	isPublic := img.GetScope() == imageScopePublic
+	isOwned := img.GetOwner() == projectId
+	switch filter.OwnerScope.ValueString() {
+	case ownerScopePublic:
+		return isPublic
+	case ownerScopePrivate:
+		return !isPublic && isOwned
+	case ownerScopeShared:
+		return !isPublic && !isOwned
+	default:
+		return false
+	}
+}
+
+// selectImages returns the images matching the name or name regex and all filter conditions.
+// The result is sorted by name, or by creation date (newest first) if mostRecent is set.
+func selectImages(images []iaas.Image, projectId, name string, nameRegex *regexp.Regexp, filter *Filter, sortAscending, mostRecent bool) []*iaas.Image {
+	selected := []*iaas.Image{}
+	for i := range images {
+		img := &images[i]
+		if name != "" && (img.Name == nil || *img.Name != name) {
+			continue
+		}
+		if nameRegex != nil && (img.Name == nil || !nameRegex.MatchString(*img.Name)) {
+			continue
+		}
+		if !imageMatchesFilter(img, filter) || !imageMatchesLabels(img, filter) || !imageMatchesOwnerScope(img, filter, projectId) {
+			continue
+		}
+		selected = append(selected, img)
+	}
+
+	sortImagesByName(selected, sortAscending)
+	if mostRecent {
+		sortImagesByCreatedAt(selected)
+	}
+	return selected
+}
+
+// sortImagesByCreatedAt sorts a slice of images by creation date, newest first. Images without creation date go last.
+// The sort is stable, so images created at the same time keep their previous order.
+func sortImagesByCreatedAt(images []*iaas.Image) {
+	sort.SliceStable(images, func(i, j int) bool {
+		a, b := images[i].CreatedAt, images[j].CreatedAt
+
+		switch {
+		case a == nil:
+			return false
+		case b == nil:
+			return true
+		default:
+			return a.After(*b)
+		}
+	})
+}
+
+// isPinned reports whether the pin_until timestamp lies after now.
+func isPinned(pinUntil types.String, now time.Time) (bool, error) {
+	if pinUntil.IsNull() || pinUntil.IsUnknown() {
+		return false, nil
+	}
+	until, err := time.Parse(time.RFC3339, pinUntil.ValueString())
+	if err != nil {
+		return false, fmt.Errorf("parsing pin_until: %w", err)
+	}
+	return now.Before(until), nil
+}
@@ -0,0 +1,235 @@
+package image
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestImageMatchesLabels(t *testing.T) {
+	testCases := []struct {
+		name     string
+		img      *iaas.Image
+		filter   *Filter
+		expected bool
+	}{
+		{
+			name:     "nil filter",
+			img:      &iaas.Image{},
+			filter:   nil,
+			expected: true,
+		},
+		{
+			name:     "no labels in filter",
+			img:      &iaas.Image{},
+			filter:   &Filter{Labels: types.MapNull(types.StringType)},
+			expected: true,
+		},
+		{
+			name: "all labels match",
+			img: &iaas.Image{
+				Labels: &map[string]interface{}{"os": "ubuntu", "channel": "stable"},
+			},
+			filter: &Filter{
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"channel": types.StringValue("stable"),
+				}),
+			},
+			expected: true,
+		},
+		{
+			name: "label value mismatch",
+			img: &iaas.Image{
+				Labels: &map[string]interface{}{"channel": "beta"},
+			},
+			filter: &Filter{
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"channel": types.StringValue("stable"),
+				}),
+			},
+			expected: false,
+		},
+		{
+			name: "label missing in image",
+			img:  &iaas.Image{},
+			filter: &Filter{
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"channel": types.StringValue("stable"),
+				}),
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := imageMatchesLabels(tc.img, tc.filter)
+			if result != tc.expected {
+				t.Errorf("Expected match = %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestImageMatchesOwnerScope(t *testing.T) {
+	publicImage := &iaas.Image{Scope: utils.Ptr("public"), Owner: utils.Ptr("stackit")}
+	privateImage := &iaas.Image{Scope: utils.Ptr("local"), Owner: utils.Ptr("pid")}
+	sharedImage := &iaas.Image{Scope: utils.Ptr("projects"), Owner: utils.Ptr("other-pid")}
+
+	testCases := []struct {
+		name       string
+		ownerScope types.String
+		expected   []bool
+	}{
+		{"no owner scope", types.StringNull(), []bool{true, true, true}},
+		{"public", types.StringValue(ownerScopePublic), []bool{true, false, false}},
+		{"private", types.StringValue(ownerScopePrivate), []bool{false, true, false}},
+		{"shared", types.StringValue(ownerScopeShared), []bool{false, false, true}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := &Filter{OwnerScope: tc.ownerScope}
+			result := []bool{
+				imageMatchesOwnerScope(publicImage, filter, "pid"),
+				imageMatchesOwnerScope(privateImage, filter, "pid"),
+				imageMatchesOwnerScope(sharedImage, filter, "pid"),
+			}
+			if diff := cmp.Diff(tc.expected, result); diff != "" {
+				t.Fatalf("unexpected matches (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectImages(t *testing.T) {
+	images := []iaas.Image{
+		{
+			Name:      utils.Ptr("Ubuntu 22.04"),
+			CreatedAt: utils.Ptr(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)),
+			Config:    &iaas.ImageConfig{Architecture: utils.Ptr("x86")},
+		},
+		{
+			Name:      utils.Ptr("Ubuntu 24.04"),
+			CreatedAt: utils.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Config:    &iaas.ImageConfig{Architecture: utils.Ptr("x86")},
+		},
+		{
+			Name:      utils.Ptr("Ubuntu 24.04 ARM"),
+			CreatedAt: utils.Ptr(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)),
+			Config:    &iaas.ImageConfig{Architecture: utils.Ptr("arm64")},
+		},
+		{
+			Name: utils.Ptr("Debian 12"),
+		},
+	}
+
+	tests := []struct {
+		desc          string
+		name          string
+		nameRegex     *regexp.Regexp
+		filter        *Filter
+		sortAscending bool
+		mostRecent    bool
+		want          []string
+	}{
+		{
+			desc:   "name match",
+			name:   "Ubuntu 24.04",
+			filter: &Filter{},
+			want:   []string{"Ubuntu 24.04"},
+		},
+		{
+			desc:      "regex sorted descending by name",
+			nameRegex: regexp.MustCompile("^Ubuntu"),
+			filter:    &Filter{},
+			want:      []string{"Ubuntu 24.04 ARM", "Ubuntu 24.04", "Ubuntu 22.04"},
+		},
+		{
+			desc:       "regex most recent",
+			nameRegex:  regexp.MustCompile("^Ubuntu"),
+			filter:     &Filter{},
+			mostRecent: true,
+			want:       []string{"Ubuntu 22.04", "Ubuntu 24.04 ARM", "Ubuntu 24.04"},
+		},
+		{
+			desc:          "architecture filter",
+			filter:        &Filter{Architecture: types.StringValue("x86")},
+			sortAscending: true,
+			want:          []string{"Ubuntu 22.04", "Ubuntu 24.04"},
+		},
+		{
+			desc:   "no match",
+			name:   "Windows",
+			filter: &Filter{},
+			want:   []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			selected := selectImages(images, "pid", tc.name, tc.nameRegex, tc.filter, tc.sortAscending, tc.mostRecent)
+
+			gotNames := []string{}
+			for _, img := range selected {
+				gotNames = append(gotNames, img.GetName())
+			}
+			if diff := cmp.Diff(tc.want, gotNames); diff != "" {
+				t.Fatalf("incorrect selection (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSortImagesByCreatedAt(t *testing.T) {
+	input := []*iaas.Image{
+		{Name: utils.Ptr("no date")},
+		{Name: utils.Ptr("old"), CreatedAt: utils.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{Name: utils.Ptr("new"), CreatedAt: utils.Ptr(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))},
+	}
+	sortImagesByCreatedAt(input)
+
+	gotNames := make([]string, len(input))
+	for i, img := range input {
+		gotNames[i] = img.GetName()
+	}
+	if diff := cmp.Diff([]string{"new", "old", "no date"}, gotNames); diff != "" {
+		t.Fatalf("incorrect sort order (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsPinned(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		desc     string
+		pinUntil types.String
+		expected bool
+		isValid  bool
+	}{
+		{"not set", types.StringNull(), false, true},
+		{"pin in future", types.StringValue("2025-12-31T00:00:00Z"), true, true},
+		{"pin expired", types.StringValue("2025-01-01T00:00:00Z"), false, true},
+		{"invalid date", types.StringValue("tomorrow"), false, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			pinned, err := isPinned(tc.pinUntil, now)
+			if !tc.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tc.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if pinned != tc.expected {
+				t.Fatalf("Expected pinned = %v, got %v", tc.expected, pinned)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &imagesDataSource{}
+)
+
+type ImagesModel struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	ProjectId     types.String `tfsdk:"project_id"`
+	Name          types.String `tfsdk:"name"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+	SortAscending types.Bool   `tfsdk:"sort_ascending"`
+	MostRecent    types.Bool   `tfsdk:"most_recent"`
+	Filter        types.Object `tfsdk:"filter"`
+	Images        types.List   `tfsdk:"images"`
+}
+
+// Types corresponding to an element of ImagesModel.Images
+var imageTypes = map[string]attr.Type{
+	"image_id":                 basetypes.StringType{},
+	"name":                     basetypes.StringType{},
+	"created_at":               basetypes.StringType{},
+	"scope":                    basetypes.StringType{},
+	"owner":                    basetypes.StringType{},
+	"disk_format":              basetypes.StringType{},
+	"min_disk_size":            basetypes.Int64Type{},
+	"min_ram":                  basetypes.Int64Type{},
+	"protected":                basetypes.BoolType{},
+	"architecture":             basetypes.StringType{},
+	"operating_system":         basetypes.StringType{},
+	"operating_system_distro":  basetypes.StringType{},
+	"operating_system_version": basetypes.StringType{},
+	"labels":                   basetypes.MapType{ElemType: types.StringType},
+}
+
+// NewImagesDataSource is a helper function to simplify the provider implementation.
+func NewImagesDataSource() datasource.DataSource {
+	return &imagesDataSource{}
+}
+
+// imagesDataSource is the data source implementation.
+type imagesDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *imagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_images"
+}
+
+func (d *imagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_images", "datasource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+func (d *imagesDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("name"),
+			path.MatchRoot("name_regex"),
+		),
+	}
+}
+
+// Schema defines the schema for the datasource.
+func (d *imagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := features.AddBetaDescription("Images datasource schema. Lists all images matching the `name` or `name_regex` and the `filter`. Must have a `region` specified in the provider configuration.", core.Datasource)
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the images are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Exact image name to match. Cannot be used together with `name_regex`.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match against image names. Cannot be used together with `name`.",
+				Optional:    true,
+			},
+			"sort_ascending": schema.BoolAttribute{
+				Description: "If set to `true`, images are sorted in ascending lexicographical order by image name. Defaults to `false` (descending).",
+				Optional:    true,
+			},
+			"most_recent": schema.BoolAttribute{
+				Description: "If set to `true`, images are sorted by creation date, newest first. Images created at the same time are ordered by `sort_ascending`.",
+				Optional:    true,
+			},
+			"filter": filterAttribute(),
+			"images": schema.ListNestedAttribute{
+				Description: "The images matching all criteria.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"image_id": schema.StringAttribute{
+							Description: "The image ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the image.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Date-time when the image was created.",
+							Computed:    true,
+						},
+						"scope": schema.StringAttribute{
+							Description: "The scope of the image.",
+							Computed:    true,
+						},
+						"owner": schema.StringAttribute{
+							Description: "The ID of the project owning the image.",
+							Computed:    true,
+						},
+						"disk_format": schema.StringAttribute{
+							Description: "The disk format of the image.",
+							Computed:    true,
+						},
+						"min_disk_size": schema.Int64Attribute{
+							Description: "The minimum disk size of the image in GB.",
+							Computed:    true,
+						},
+						"min_ram": schema.Int64Attribute{
+							Description: "The minimum RAM of the image in MB.",
+							Computed:    true,
+						},
+						"protected": schema.BoolAttribute{
+							Description: "Whether the image is protected.",
+							Computed:    true,
+						},
+						"architecture": schema.StringAttribute{
+							Description: "CPU architecture of the image.",
+							Computed:    true,
+						},
+						"operating_system": schema.StringAttribute{
+							Description: "Operating system of the image.",
+							Computed:    true,
+						},
+						"operating_system_distro": schema.StringAttribute{
+							Description: "Operating system distribution.",
+							Computed:    true,
+						},
+						"operating_system_version": schema.StringAttribute{
+							Description: "Version of the operating system.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *imagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model ImagesModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := model.ProjectId.ValueString()
+	nameRegex := model.NameRegex.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectID)
+
+	var filter Filter
+	if !model.Filter.IsNull() && !model.Filter.IsUnknown() {
+		if diagnostics := model.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{}); diagnostics.HasError() {
+			resp.Diagnostics.Append(diagnostics...)
+			return
+		}
+	}
+
+	var compiledRegex *regexp.Regexp
+	if nameRegex != "" {
+		var err error
+		compiledRegex, err = regexp.Compile(nameRegex)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	imageList, err := d.client.ListImages(ctx, projectID).Execute()
+	if err != nil {
+		utils.LogError(ctx, &resp.Diagnostics, err, "List images",
+			fmt.Sprintf("Unable to fetch images of project %q.", projectID),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectID),
+			})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	images := selectImages(imageList.GetItems(), projectID, model.Name.ValueString(), compiledRegex, &filter, model.SortAscending.ValueBool(), model.MostRecent.ValueBool())
+	err = mapImagesFields(ctx, images, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading images", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "images read")
+}
+
+func mapImagesFields(ctx context.Context, images []*iaas.Image, model *ImagesModel) error {
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	imagesList := []attr.Value{}
+	for _, img := range images {
+		if img == nil {
+			continue
+		}
+		if img.Id == nil {
+			return fmt.Errorf("image id not present")
+		}
+
+		labels, err := iaasUtils.MapLabels(ctx, img.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of image %q: %w", *img.Id, err)
+		}
+
+		createdAt := types.StringNull()
+		if img.CreatedAt != nil {
+			createdAt = types.StringValue(img.CreatedAt.Format(time.RFC3339))
+		}
+
+		var architecture, operatingSystem, operatingSystemDistro, operatingSystemVersion *string
+		if img.Config != nil {
+			architecture = img.Config.Architecture
+			operatingSystem = img.Config.OperatingSystem
+			operatingSystemDistro = img.Config.GetOperatingSystemDistro()
+			operatingSystemVersion = img.Config.GetOperatingSystemVersion()
+		}
+
+		imageTF, diags := types.ObjectValue(imageTypes, map[string]attr.Value{
+			"image_id":                 types.StringPointerValue(img.Id),
+			"name":                     types.StringPointerValue(img.Name),
+			"created_at":               createdAt,
+			"scope":                    types.StringPointerValue(img.Scope),
+			"owner":                    types.StringPointerValue(img.Owner),
+			"disk_format":              types.StringPointerValue(img.DiskFormat),
+			"min_disk_size":            types.Int64PointerValue(img.MinDiskSize),
+			"min_ram":                  types.Int64PointerValue(img.MinRam),
+			"protected":                types.BoolPointerValue(img.Protected),
+			"architecture":             types.StringPointerValue(architecture),
+			"operating_system":         types.StringPointerValue(operatingSystem),
+			"operating_system_distro":  types.StringPointerValue(operatingSystemDistro),
+			"operating_system_version": types.StringPointerValue(operatingSystemVersion),
+			"labels":                   labels,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping image %q: %w", *img.Id, core.DiagsToError(diags))
+		}
+		imagesList = append(imagesList, imageTF)
+	}
+
+	imagesTF, diags := types.ListValue(types.ObjectType{AttrTypes: imageTypes}, imagesList)
+	if diags.HasError() {
+		return fmt.Errorf("mapping images: %w", core.DiagsToError(diags))
+	}
+
+	model.Id = types.StringValue(model.ProjectId.ValueString())
+	model.Images = imagesTF
+	return nil
+}
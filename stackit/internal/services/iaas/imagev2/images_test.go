@@ -0,0 +1,150 @@
+package image
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapImagesFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       ImagesModel
+		input       []*iaas.Image
+		expected    ImagesModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			ImagesModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			[]*iaas.Image{
+				{Id: utils.Ptr("iid")},
+			},
+			ImagesModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Images: types.ListValueMust(types.ObjectType{AttrTypes: imageTypes}, []attr.Value{
+					types.ObjectValueMust(imageTypes, map[string]attr.Value{
+						"image_id":                 types.StringValue("iid"),
+						"name":                     types.StringNull(),
+						"created_at":               types.StringNull(),
+						"scope":                    types.StringNull(),
+						"owner":                    types.StringNull(),
+						"disk_format":              types.StringNull(),
+						"min_disk_size":            types.Int64Null(),
+						"min_ram":                  types.Int64Null(),
+						"protected":                types.BoolNull(),
+						"architecture":             types.StringNull(),
+						"operating_system":         types.StringNull(),
+						"operating_system_distro":  types.StringNull(),
+						"operating_system_version": types.StringNull(),
+						"labels":                   types.MapNull(types.StringType),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			ImagesModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			[]*iaas.Image{
+				{
+					Id:          utils.Ptr("iid"),
+					Name:        utils.Ptr("name"),
+					CreatedAt:   utils.Ptr(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)),
+					Scope:       utils.Ptr("public"),
+					Owner:       utils.Ptr("owner"),
+					DiskFormat:  utils.Ptr("qcow2"),
+					MinDiskSize: utils.Ptr(int64(10)),
+					MinRam:      utils.Ptr(int64(512)),
+					Protected:   utils.Ptr(true),
+					Config: &iaas.ImageConfig{
+						Architecture:           utils.Ptr("x86"),
+						OperatingSystem:        utils.Ptr("linux"),
+						OperatingSystemDistro:  iaas.NewNullableString(utils.Ptr("ubuntu")),
+						OperatingSystemVersion: iaas.NewNullableString(utils.Ptr("24.04")),
+					},
+					Labels: &map[string]interface{}{
+						"key": "value",
+					},
+				},
+			},
+			ImagesModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Images: types.ListValueMust(types.ObjectType{AttrTypes: imageTypes}, []attr.Value{
+					types.ObjectValueMust(imageTypes, map[string]attr.Value{
+						"image_id":                 types.StringValue("iid"),
+						"name":                     types.StringValue("name"),
+						"created_at":               types.StringValue("2025-01-02T03:04:05Z"),
+						"scope":                    types.StringValue("public"),
+						"owner":                    types.StringValue("owner"),
+						"disk_format":              types.StringValue("qcow2"),
+						"min_disk_size":            types.Int64Value(10),
+						"min_ram":                  types.Int64Value(512),
+						"protected":                types.BoolValue(true),
+						"architecture":             types.StringValue("x86"),
+						"operating_system":         types.StringValue("linux"),
+						"operating_system_distro":  types.StringValue("ubuntu"),
+						"operating_system_version": types.StringValue("24.04"),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"key": types.StringValue("value"),
+						}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"no_images",
+			ImagesModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			[]*iaas.Image{},
+			ImagesModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Images:    types.ListValueMust(types.ObjectType{AttrTypes: imageTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"image_id_missing",
+			ImagesModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			[]*iaas.Image{
+				{Name: utils.Ptr("name")},
+			},
+			ImagesModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapImagesFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package machineType
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 	"sort"
 	"strings"
@@ -26,16 +27,15 @@ import (
 var _ datasource.DataSource = &machineTypeDataSource{}
 
 type DataSourceModel struct {
-	Id            types.String `tfsdk:"id"` // required by Terraform to identify state
-	ProjectId     types.String `tfsdk:"project_id"`
-	SortAscending types.Bool   `tfsdk:"sort_ascending"`
-	Filter        types.String `tfsdk:"filter"`
-	Description   types.String `tfsdk:"description"`
-	Disk          types.Int64  `tfsdk:"disk"`
-	ExtraSpecs    types.Map    `tfsdk:"extra_specs"`
-	Name          types.String `tfsdk:"name"`
-	Ram           types.Int64  `tfsdk:"ram"`
-	Vcpus         types.Int64  `tfsdk:"vcpus"`
+	FilterModel
+	Id          types.String `tfsdk:"id"` // required by Terraform to identify state
+	ProjectId   types.String `tfsdk:"project_id"`
+	Description types.String `tfsdk:"description"`
+	Disk        types.Int64  `tfsdk:"disk"`
+	ExtraSpecs  types.Map    `tfsdk:"extra_specs"`
+	Name        types.String `tfsdk:"name"`
+	Ram         types.Int64  `tfsdk:"ram"`
+	Vcpus       types.Int64  `tfsdk:"vcpus"`
 }
 
 // NewMachineTypeDataSource instantiates the data source
@@ -72,65 +72,51 @@ func (d *machineTypeDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *machineTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: features.AddBetaDescription("Machine type data source.", core.Datasource),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`image_id`\".",
-				Computed:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "STACKIT Project ID.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.UUID(),
-					validate.NoSeparator(),
-				},
-			},
-			"sort_ascending": schema.BoolAttribute{
-				Description: "Sort machine types by name ascending (`true`) or descending (`false`). Defaults to `false`",
-				Optional:    true,
-			},
-			"filter": schema.StringAttribute{
-				Description: "Expr-lang filter for filtering machine types.\n\n" +
-					"Examples:\n" +
-					"- vcpus == 2\n" +
-					"- ram >= 2048\n" +
-					"- extraSpecs.cpu == \"intel-icelake-generic\"\n" +
-					"- extraSpecs.cpu == \"intel-icelake-generic\" && vcpus == 2\n\n" +
-					"Syntax reference: https://expr-lang.org/docs/language-definition\n\n" +
-					"You can also list available machine-types using the [STACKIT CLI](https://github.com/stackitcloud/stackit-cli):\n\n" +
-					"```bash\n" +
-					"stackit server machine-type list\n" +
-					"```",
-				Required: true,
-			},
-			"description": schema.StringAttribute{
-				Description: "Machine type description.",
-				Computed:    true,
-			},
-			"disk": schema.Int64Attribute{
-				Description: "Disk size in GB.",
-				Computed:    true,
-			},
-			"extra_specs": schema.MapAttribute{
-				Description: "Extra specs (e.g., CPU type, overcommit ratio).",
-				ElementType: types.StringType,
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Name of the machine type (e.g. 's1.2').",
-				Computed:    true,
-			},
-			"ram": schema.Int64Attribute{
-				Description: "RAM size in MB.",
-				Computed:    true,
-			},
-			"vcpus": schema.Int64Attribute{
-				Description: "Number of vCPUs.",
-				Computed:    true,
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`image_id`\".",
+			Computed:    true,
+		},
+		"project_id": schema.StringAttribute{
+			Description: "STACKIT Project ID.",
+			Required:    true,
+			Validators: []validator.String{
+				validate.UUID(),
+				validate.NoSeparator(),
 			},
 		},
+		"description": schema.StringAttribute{
+			Description: "Machine type description.",
+			Computed:    true,
+		},
+		"disk": schema.Int64Attribute{
+			Description: "Disk size in GB.",
+			Computed:    true,
+		},
+		"extra_specs": schema.MapAttribute{
+			Description: "Extra specs (e.g., CPU type, overcommit ratio).",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the machine type (e.g. 's1.2').",
+			Computed:    true,
+		},
+		"ram": schema.Int64Attribute{
+			Description: "RAM size in MB.",
+			Computed:    true,
+		},
+		"vcpus": schema.Int64Attribute{
+			Description: "Number of vCPUs.",
+			Computed:    true,
+		},
+	}
+	maps.Copy(attributes, filterAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: features.AddBetaDescription("Machine type data source. Returns the first machine type matching all filters, in the order given by `sort_by` and `sort_ascending`. "+
+			"To select the smallest machine type satisfying some requirements, set the `min_*` filters together with `sort_by = \"size\"` and `sort_ascending = true`.", core.Datasource),
+		Attributes: attributes,
 	}
 }
 
@@ -177,11 +163,21 @@ func (d *machineTypeDataSource) Read(ctx context.Context, req datasource.ReadReq
 		machineTypes[i] = &(*apiResp.Items)[i]
 	}
 
-	sorted, err := sortMachineTypeByName(machineTypes, sortAscending)
+	machineTypes, err = filterMachineTypes(machineTypes, &model.FilterModel)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading machine type", fmt.Sprintf("Filtering machine types: %v", err))
+		return
+	}
+
+	sorted, err := sortMachineTypes(machineTypes, model.SortBy.ValueString(), sortAscending)
 	if err != nil {
 		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Unable to sort", err.Error())
 		return
 	}
+	if len(sorted) == 0 {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "No machine types found", "No matching machine types.")
+		return
+	}
 
 	if err := mapDataSourceFields(ctx, sorted[0], &model); err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading machine type", fmt.Sprintf("Failed to translate API response: %v", err))
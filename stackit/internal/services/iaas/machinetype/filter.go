@@ -0,0 +1,215 @@
+package machineType
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+const (
+	sortByName  = "name"
+	sortByVcpus = "vcpus"
+	sortByRam   = "ram"
+	sortByDisk  = "disk"
+	sortBySize  = "size"
+)
+
+var sortByOptions = []string{sortByName, sortByVcpus, sortByRam, sortByDisk, sortBySize}
+
+// FilterModel holds the attributes to select machine types, shared by the machine type data sources.
+type FilterModel struct {
+	Filter          types.String       `tfsdk:"filter"`
+	SortAscending   types.Bool         `tfsdk:"sort_ascending"`
+	SortBy          types.String       `tfsdk:"sort_by"`
+	MinVcpus        types.Int64        `tfsdk:"min_vcpus"`
+	MinRam          types.Int64        `tfsdk:"min_ram"`
+	MinDisk         types.Int64        `tfsdk:"min_disk"`
+	ExtraSpecsMatch *map[string]string `tfsdk:"extra_specs_match"`
+	CpuArchitecture types.String       `tfsdk:"cpu_architecture"`
+	Gpu             types.Bool         `tfsdk:"gpu"`
+}
+
+// filterAttributes returns the schema attributes of FilterModel.
+func filterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"sort_ascending": schema.BoolAttribute{
+			Description: "Sort machine types ascending (`true`) or descending (`false`) by `sort_by`. Defaults to `false`",
+			Optional:    true,
+		},
+		"sort_by": schema.StringAttribute{
+			Description: fmt.Sprintf("The attribute to sort the machine types by. `%s` sorts by vCPUs, then RAM, then disk size, which can be used as a proxy for the price class. Defaults to `%s`. %s", sortBySize, sortByName, utils.SupportedValuesDocumentation(sortByOptions)),
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(sortByOptions...),
+			},
+		},
+		"filter": schema.StringAttribute{
+			Description: "Expr-lang filter for filtering machine types.\n\n" +
+				"Examples:\n" +
+				"- vcpus == 2\n" +
+				"- ram >= 2048\n" +
+				"- extraSpecs.cpu == \"intel-icelake-generic\"\n" +
+				"- extraSpecs.cpu == \"intel-icelake-generic\" && vcpus == 2\n\n" +
+				"Syntax reference: https://expr-lang.org/docs/language-definition\n\n" +
+				"You can also list available machine-types using the [STACKIT CLI](https://github.com/stackitcloud/stackit-cli):\n\n" +
+				"```bash\n" +
+				"stackit server machine-type list\n" +
+				"```",
+			Optional: true,
+		},
+		"min_vcpus": schema.Int64Attribute{
+			Description: "Minimum number of vCPUs.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"min_ram": schema.Int64Attribute{
+			Description: "Minimum RAM size in MB.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"min_disk": schema.Int64Attribute{
+			Description: "Minimum disk size in GB.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"extra_specs_match": schema.MapAttribute{
+			Description: "Extra specs the machine types must have with exactly the given values, e.g. `{ overcommit = \"1\" }`.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"cpu_architecture": schema.StringAttribute{
+			Description: "CPU the machine types must have, matched case-insensitively against the beginning of the `cpu` extra spec, e.g. `intel`, `amd` or `intel-icelake`.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"gpu": schema.BoolAttribute{
+			Description: "If set to `true`, only machine types with a GPU are returned, if set to `false`, only machine types without a GPU. A machine type has a GPU if one of its extra spec keys contains `gpu` or it has the `pci_passthrough:alias` extra spec.",
+			Optional:    true,
+		},
+	}
+}
+
+// filterMachineTypes returns the machine types which satisfy all typed filters of the model.
+func filterMachineTypes(machineTypes []*iaas.MachineType, filter *FilterModel) ([]*iaas.MachineType, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("filter is nil")
+	}
+	extraSpecsMatch := map[string]string{}
+	if filter.ExtraSpecsMatch != nil {
+		extraSpecsMatch = *filter.ExtraSpecsMatch
+	}
+
+	filtered := []*iaas.MachineType{}
+	for _, machineType := range machineTypes {
+		if machineType == nil {
+			continue
+		}
+		if !filter.MinVcpus.IsNull() && machineType.GetVcpus() < filter.MinVcpus.ValueInt64() {
+			continue
+		}
+		if !filter.MinRam.IsNull() && machineType.GetRam() < filter.MinRam.ValueInt64() {
+			continue
+		}
+		if !filter.MinDisk.IsNull() && machineType.GetDisk() < filter.MinDisk.ValueInt64() {
+			continue
+		}
+		extraSpecs := machineType.GetExtraSpecs()
+		if !matchesExtraSpecs(extraSpecs, extraSpecsMatch) {
+			continue
+		}
+		if !filter.CpuArchitecture.IsNull() {
+			cpu, _ := extraSpecs["cpu"].(string)
+			if !strings.HasPrefix(strings.ToLower(cpu), strings.ToLower(filter.CpuArchitecture.ValueString())) {
+				continue
+			}
+		}
+		if !filter.Gpu.IsNull() && hasGpu(extraSpecs) != filter.Gpu.ValueBool() {
+			continue
+		}
+		filtered = append(filtered, machineType)
+	}
+	return filtered, nil
+}
+
+func matchesExtraSpecs(extraSpecs map[string]interface{}, match map[string]string) bool {
+	for key, value := range match {
+		actual, ok := extraSpecs[key]
+		if !ok || fmt.Sprint(actual) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func hasGpu(extraSpecs map[string]interface{}) bool {
+	for key := range extraSpecs {
+		if strings.Contains(strings.ToLower(key), "gpu") || key == "pci_passthrough:alias" {
+			return true
+		}
+	}
+	return false
+}
+
+// sortMachineTypes sorts the machine types by the given attribute. Ties are broken by size and then by name.
+func sortMachineTypes(input []*iaas.MachineType, sortBy string, ascending bool) ([]*iaas.MachineType, error) {
+	if sortBy == "" || sortBy == sortByName {
+		return sortMachineTypeByName(input, ascending)
+	}
+	if input == nil {
+		return nil, fmt.Errorf("input slice is nil")
+	}
+	if !slices.Contains(sortByOptions, sortBy) {
+		return nil, fmt.Errorf("unknown sort attribute %q", sortBy)
+	}
+
+	// Filter out nil or missing name
+	var filtered []*iaas.MachineType
+	for _, m := range input {
+		if m != nil && m.Name != nil {
+			filtered = append(filtered, m)
+		}
+	}
+
+	slices.SortStableFunc(filtered, func(a, b *iaas.MachineType) int {
+		var c int
+		switch sortBy {
+		case sortByVcpus:
+			c = cmp.Compare(a.GetVcpus(), b.GetVcpus())
+		case sortByRam:
+			c = cmp.Compare(a.GetRam(), b.GetRam())
+		case sortByDisk:
+			c = cmp.Compare(a.GetDisk(), b.GetDisk())
+		}
+		if c == 0 {
+			c = cmp.Or(
+				cmp.Compare(a.GetVcpus(), b.GetVcpus()),
+				cmp.Compare(a.GetRam(), b.GetRam()),
+				cmp.Compare(a.GetDisk(), b.GetDisk()),
+				cmp.Compare(a.GetName(), b.GetName()),
+			)
+		}
+		if ascending {
+			return c
+		}
+		return -c
+	})
+
+	return filtered, nil
+}
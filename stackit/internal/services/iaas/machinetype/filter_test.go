@@ -0,0 +1,220 @@
+package machineType
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func testMachineTypes() []*iaas.MachineType {
+	return []*iaas.MachineType{
+		{
+			Name:       utils.Ptr("c1.2"),
+			Vcpus:      utils.Ptr(int64(2)),
+			Ram:        utils.Ptr(int64(4096)),
+			Disk:       utils.Ptr(int64(20)),
+			ExtraSpecs: &map[string]interface{}{"cpu": "intel-icelake-generic", "overcommit": "1"},
+		},
+		{
+			Name:       utils.Ptr("g1.4"),
+			Vcpus:      utils.Ptr(int64(4)),
+			Ram:        utils.Ptr(int64(16384)),
+			Disk:       utils.Ptr(int64(50)),
+			ExtraSpecs: &map[string]interface{}{"cpu": "amd-epyc-rome", "pci_passthrough:alias": "nvidia-a100:1"},
+		},
+		{
+			Name:       utils.Ptr("m1.2"),
+			Vcpus:      utils.Ptr(int64(2)),
+			Ram:        utils.Ptr(int64(16384)),
+			Disk:       utils.Ptr(int64(20)),
+			ExtraSpecs: &map[string]interface{}{"cpu": "amd-epyc-rome", "overcommit": "2"},
+		},
+		nil,
+	}
+}
+
+func TestFilterMachineTypes(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      *FilterModel
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:     "no filters",
+			filter:   &FilterModel{},
+			expected: []string{"c1.2", "g1.4", "m1.2"},
+		},
+		{
+			name: "min vcpus",
+			filter: &FilterModel{
+				MinVcpus: types.Int64Value(3),
+			},
+			expected: []string{"g1.4"},
+		},
+		{
+			name: "min ram and min disk",
+			filter: &FilterModel{
+				MinRam:  types.Int64Value(8192),
+				MinDisk: types.Int64Value(20),
+			},
+			expected: []string{"g1.4", "m1.2"},
+		},
+		{
+			name: "extra specs match",
+			filter: &FilterModel{
+				ExtraSpecsMatch: &map[string]string{"overcommit": "1"},
+			},
+			expected: []string{"c1.2"},
+		},
+		{
+			name: "extra specs match missing key",
+			filter: &FilterModel{
+				ExtraSpecsMatch: &map[string]string{"foo": "bar"},
+			},
+			expected: []string{},
+		},
+		{
+			name: "cpu architecture",
+			filter: &FilterModel{
+				CpuArchitecture: types.StringValue("AMD"),
+			},
+			expected: []string{"g1.4", "m1.2"},
+		},
+		{
+			name: "gpu",
+			filter: &FilterModel{
+				Gpu: types.BoolValue(true),
+			},
+			expected: []string{"g1.4"},
+		},
+		{
+			name: "no gpu",
+			filter: &FilterModel{
+				Gpu: types.BoolValue(false),
+			},
+			expected: []string{"c1.2", "m1.2"},
+		},
+		{
+			name:        "nil filter",
+			filter:      nil,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := filterMachineTypes(testMachineTypes(), tt.filter)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			result := []string{}
+			for _, mt := range filtered {
+				result = append(result, mt.GetName())
+			}
+			if diff := cmp.Diff(tt.expected, result); diff != "" {
+				t.Errorf("unexpected filter result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasGpu(t *testing.T) {
+	tests := []struct {
+		name       string
+		extraSpecs map[string]interface{}
+		expected   bool
+	}{
+		{"no extra specs", nil, false},
+		{"cpu only", map[string]interface{}{"cpu": "intel-icelake-generic"}, false},
+		{"gpu key", map[string]interface{}{"resources:VGPU": "1"}, true},
+		{"pci passthrough", map[string]interface{}{"pci_passthrough:alias": "nvidia-a100:1"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasGpu(tt.extraSpecs); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSortMachineTypes(t *testing.T) {
+	tests := []struct {
+		name        string
+		sortBy      string
+		ascending   bool
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:      "default sorts by name",
+			sortBy:    "",
+			ascending: true,
+			expected:  []string{"c1.2", "g1.4", "m1.2"},
+		},
+		{
+			name:      "ram ascending",
+			sortBy:    sortByRam,
+			ascending: true,
+			expected:  []string{"c1.2", "m1.2", "g1.4"},
+		},
+		{
+			name:      "vcpus descending",
+			sortBy:    sortByVcpus,
+			ascending: false,
+			expected:  []string{"g1.4", "m1.2", "c1.2"},
+		},
+		{
+			name:      "disk ascending",
+			sortBy:    sortByDisk,
+			ascending: true,
+			expected:  []string{"c1.2", "m1.2", "g1.4"},
+		},
+		{
+			name:      "size ascending",
+			sortBy:    sortBySize,
+			ascending: true,
+			expected:  []string{"c1.2", "m1.2", "g1.4"},
+		},
+		{
+			name:        "unknown attribute",
+			sortBy:      "price",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, err := sortMachineTypes(testMachineTypes(), tt.sortBy, tt.ascending)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var result []string
+			for _, mt := range sorted {
+				result = append(result, mt.GetName())
+			}
+			if diff := cmp.Diff(tt.expected, result); diff != "" {
+				t.Errorf("unexpected sorted order (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package machineType
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ datasource.DataSource = &machineTypesDataSource{}
+
+type MachineTypesModel struct {
+	FilterModel
+	Id           types.String `tfsdk:"id"` // required by Terraform to identify state
+	ProjectId    types.String `tfsdk:"project_id"`
+	MachineTypes types.List   `tfsdk:"machine_types"`
+}
+
+// machineTypeTypes are the types of an element of MachineTypesModel.MachineTypes
+var machineTypeTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"description": types.StringType,
+	"disk":        types.Int64Type,
+	"extra_specs": types.MapType{ElemType: types.StringType},
+	"ram":         types.Int64Type,
+	"vcpus":       types.Int64Type,
+}
+
+// NewMachineTypesDataSource instantiates the data source
+func NewMachineTypesDataSource() datasource.DataSource {
+	return &machineTypesDataSource{}
+}
+
+type machineTypesDataSource struct {
+	client *iaas.APIClient
+}
+
+func (d *machineTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_types"
+}
+
+func (d *machineTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckBetaResourcesEnabled(ctx, &providerData, &resp.Diagnostics, "stackit_machine_types", "datasource")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = client
+
+	tflog.Info(ctx, "IAAS client configured")
+}
+
+func (d *machineTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+			Computed:    true,
+		},
+		"project_id": schema.StringAttribute{
+			Description: "STACKIT Project ID.",
+			Required:    true,
+			Validators: []validator.String{
+				validate.UUID(),
+				validate.NoSeparator(),
+			},
+		},
+		"machine_types": schema.ListNestedAttribute{
+			Description: "The machine types matching all filters, in the order given by `sort_by` and `sort_ascending`.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of the machine type (e.g. 's1.2').",
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "Machine type description.",
+						Computed:    true,
+					},
+					"disk": schema.Int64Attribute{
+						Description: "Disk size in GB.",
+						Computed:    true,
+					},
+					"extra_specs": schema.MapAttribute{
+						Description: "Extra specs (e.g., CPU type, overcommit ratio).",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"ram": schema.Int64Attribute{
+						Description: "RAM size in MB.",
+						Computed:    true,
+					},
+					"vcpus": schema.Int64Attribute{
+						Description: "Number of vCPUs.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(attributes, filterAttributes())
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: features.AddBetaDescription("Machine types data source. Lists all machine types matching the filters.", core.Datasource),
+		Attributes:          attributes,
+	}
+}
+
+func (d *machineTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model MachineTypesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	listMachineTypeReq := d.client.ListMachineTypes(ctx, projectId)
+	if !model.Filter.IsNull() && !model.Filter.IsUnknown() && strings.TrimSpace(model.Filter.ValueString()) != "" {
+		listMachineTypeReq = listMachineTypeReq.Filter(strings.TrimSpace(model.Filter.ValueString()))
+	}
+
+	apiResp, err := listMachineTypeReq.Execute()
+	if err != nil {
+		utils.LogError(ctx, &resp.Diagnostics, err, "Failed to read machine types",
+			fmt.Sprintf("Unable to retrieve machine types for project %q %s.", projectId, err),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Access denied to project %q.", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err := mapMachineTypesFields(ctx, apiResp, &model); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading machine types", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Machine types read")
+}
+
+func mapMachineTypesFields(ctx context.Context, machineTypesResp *iaas.MachineTypeListResponse, model *MachineTypesModel) error {
+	if machineTypesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	items := machineTypesResp.GetItems()
+	machineTypes := make([]*iaas.MachineType, len(items))
+	for i := range items {
+		machineTypes[i] = &items[i]
+	}
+
+	machineTypes, err := filterMachineTypes(machineTypes, &model.FilterModel)
+	if err != nil {
+		return fmt.Errorf("filtering machine types: %w", err)
+	}
+	machineTypes, err = sortMachineTypes(machineTypes, model.SortBy.ValueString(), model.SortAscending.ValueBool())
+	if err != nil {
+		return fmt.Errorf("sorting machine types: %w", err)
+	}
+
+	machineTypesList := []attr.Value{}
+	for _, machineType := range machineTypes {
+		var machineTypeModel DataSourceModel
+		if err := mapDataSourceFields(ctx, machineType, &machineTypeModel); err != nil {
+			return err
+		}
+		machineTypeTF, diags := types.ObjectValue(machineTypeTypes, map[string]attr.Value{
+			"name":        machineTypeModel.Name,
+			"description": machineTypeModel.Description,
+			"disk":        machineTypeModel.Disk,
+			"extra_specs": machineTypeModel.ExtraSpecs,
+			"ram":         machineTypeModel.Ram,
+			"vcpus":       machineTypeModel.Vcpus,
+		})
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+		machineTypesList = append(machineTypesList, machineTypeTF)
+	}
+
+	machineTypesTF, diags := types.ListValue(types.ObjectType{AttrTypes: machineTypeTypes}, machineTypesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+
+	model.Id = types.StringValue(model.ProjectId.ValueString())
+	model.MachineTypes = machineTypesTF
+	return nil
+}
@@ -0,0 +1,133 @@
+package machineType
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapMachineTypesFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       MachineTypesModel
+		input       *iaas.MachineTypeListResponse
+		expected    MachineTypesModel
+		expectError bool
+	}{
+		{
+			name: "filtered and sorted",
+			state: MachineTypesModel{
+				FilterModel: FilterModel{
+					SortBy:        types.StringValue(sortBySize),
+					SortAscending: types.BoolValue(true),
+					MinVcpus:      types.Int64Value(2),
+				},
+				ProjectId: types.StringValue("pid"),
+			},
+			input: &iaas.MachineTypeListResponse{
+				Items: &[]iaas.MachineType{
+					{
+						Name:        utils.Ptr("s1.4"),
+						Description: utils.Ptr("large"),
+						Vcpus:       utils.Ptr(int64(4)),
+						Ram:         utils.Ptr(int64(8192)),
+						Disk:        utils.Ptr(int64(20)),
+						ExtraSpecs:  &map[string]interface{}{"cpu": "intel-icelake-generic"},
+					},
+					{
+						Name:  utils.Ptr("s1.1"),
+						Vcpus: utils.Ptr(int64(1)),
+						Ram:   utils.Ptr(int64(1024)),
+						Disk:  utils.Ptr(int64(20)),
+					},
+					{
+						Name:  utils.Ptr("s1.2"),
+						Vcpus: utils.Ptr(int64(2)),
+						Ram:   utils.Ptr(int64(4096)),
+						Disk:  utils.Ptr(int64(20)),
+					},
+				},
+			},
+			expected: MachineTypesModel{
+				FilterModel: FilterModel{
+					SortBy:        types.StringValue(sortBySize),
+					SortAscending: types.BoolValue(true),
+					MinVcpus:      types.Int64Value(2),
+				},
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				MachineTypes: types.ListValueMust(types.ObjectType{AttrTypes: machineTypeTypes}, []attr.Value{
+					types.ObjectValueMust(machineTypeTypes, map[string]attr.Value{
+						"name":        types.StringValue("s1.2"),
+						"description": types.StringNull(),
+						"disk":        types.Int64Value(20),
+						"extra_specs": types.MapNull(types.StringType),
+						"ram":         types.Int64Value(4096),
+						"vcpus":       types.Int64Value(2),
+					}),
+					types.ObjectValueMust(machineTypeTypes, map[string]attr.Value{
+						"name":        types.StringValue("s1.4"),
+						"description": types.StringValue("large"),
+						"disk":        types.Int64Value(20),
+						"extra_specs": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"cpu": types.StringValue("intel-icelake-generic"),
+						}),
+						"ram":   types.Int64Value(8192),
+						"vcpus": types.Int64Value(4),
+					}),
+				}),
+			},
+		},
+		{
+			name: "no matches",
+			state: MachineTypesModel{
+				FilterModel: FilterModel{
+					Gpu: types.BoolValue(true),
+				},
+				ProjectId: types.StringValue("pid"),
+			},
+			input: &iaas.MachineTypeListResponse{
+				Items: &[]iaas.MachineType{
+					{Name: utils.Ptr("s1.2")},
+				},
+			},
+			expected: MachineTypesModel{
+				FilterModel: FilterModel{
+					Gpu: types.BoolValue(true),
+				},
+				Id:           types.StringValue("pid"),
+				ProjectId:    types.StringValue("pid"),
+				MachineTypes: types.ListValueMust(types.ObjectType{AttrTypes: machineTypeTypes}, []attr.Value{}),
+			},
+		},
+		{
+			name:        "nil response",
+			state:       MachineTypesModel{},
+			input:       nil,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mapMachineTypesFields(context.Background(), tt.input, &tt.state)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, tt.state); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,9 +2,13 @@ package network
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaasalpha"
+	"github.com/stackitcloud/stackit-sdk-go/services/resourcemanager"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
@@ -26,15 +31,18 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/network/utils/v2network"
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 	iaasAlphaUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/utils"
+	resourcemanagerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/resourcemanager/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &networkResource{}
-	_ resource.ResourceWithConfigure   = &networkResource{}
-	_ resource.ResourceWithImportState = &networkResource{}
+	_ resource.Resource                   = &networkResource{}
+	_ resource.ResourceWithConfigure      = &networkResource{}
+	_ resource.ResourceWithImportState    = &networkResource{}
+	_ resource.ResourceWithModifyPlan     = &networkResource{}
+	_ resource.ResourceWithValidateConfig = &networkResource{}
 )
 
 // NewNetworkResource is a helper function to simplify the provider implementation.
@@ -46,9 +54,11 @@ func NewNetworkResource() resource.Resource {
 type networkResource struct {
 	client *iaas.APIClient
 	// alphaClient will be used in case the experimental flag "network" is set
-	alphaClient    *iaasalpha.APIClient
-	isExperimental bool
-	providerData   core.ProviderData
+	alphaClient *iaasalpha.APIClient
+	// resourceManagerClient is used to look up the organization of the project when validating the prefix
+	resourceManagerClient *resourcemanager.APIClient
+	isExperimental        bool
+	providerData          core.ProviderData
 }
 
 // Metadata returns the resource type name.
@@ -75,23 +85,25 @@ func (r *networkResource) Configure(ctx context.Context, req resource.ConfigureR
 			return
 		}
 		r.alphaClient = alphaApiClient
-	} else {
-		apiClient := iaasUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		r.client = apiClient
 	}
+	// The v1 client is also used with the experimental API to validate the prefix against the network area
+	apiClient := iaasUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+
+	resourceManagerClient := resourcemanagerUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.resourceManagerClient = resourceManagerClient
 	tflog.Info(ctx, "IaaS client configured")
 }
 
 // ModifyPlan implements resource.ResourceWithModifyPlan.
-// Use the modifier to set the effective region in the current plan.
+// Use the modifier to set the effective region in the current plan and to validate the prefix against the network area.
 func (r *networkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
-	// If the v1 api is used, it's not required to get the fallback region because it isn't used
-	if !r.isExperimental {
-		return
-	}
 	var configModel model.Model
 	// skip initial empty configuration to avoid follow-up errors
 	if req.Config.Raw.IsNull() {
@@ -102,6 +114,23 @@ func (r *networkResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	var stateModel model.Model
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.validatePrefixInArea(ctx, &configModel, &stateModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the v1 api is used, it's not required to get the fallback region because it isn't used
+	if !r.isExperimental {
+		return
+	}
+
 	var planModel model.Model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
 	if resp.Diagnostics.HasError() {
@@ -119,6 +148,78 @@ func (r *networkResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 	}
 }
 
+// validatePrefixInArea checks that a configured IPv4 prefix is within the network ranges of the network area the project
+// belongs to and that it doesn't overlap with other networks of the area. Projects in a static area are not checked.
+// The network area can't always be read, e.g. due to missing permissions on the organization, so API errors only result in a warning.
+func (r *networkResource) validatePrefixInArea(ctx context.Context, configModel, stateModel *model.Model, diags *diag.Diagnostics) {
+	if utils.IsUndefined(configModel.IPv4Prefix) || utils.IsUndefined(configModel.ProjectId) {
+		return
+	}
+	if configModel.IPv4Prefix.ValueString() == stateModel.IPv4Prefix.ValueString() {
+		return
+	}
+	prefix, err := netip.ParsePrefix(configModel.IPv4Prefix.ValueString())
+	if err != nil {
+		// Invalid prefixes are reported by the attribute validator
+		return
+	}
+	projectId := configModel.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	warnValidationSkipped := func(err error) {
+		diags.AddAttributeWarning(
+			path.Root("ipv4_prefix"),
+			"Network prefix not validated",
+			fmt.Sprintf("The prefix could not be validated against the network area of the project: %v", err),
+		)
+	}
+
+	project, err := r.client.GetProjectDetailsExecute(ctx, projectId)
+	if err != nil {
+		warnValidationSkipped(fmt.Errorf("reading project: %w", err))
+		return
+	}
+	if project.AreaId == nil || project.AreaId.String == nil {
+		tflog.Debug(ctx, "project is not in a network area, skipping prefix validation")
+		return
+	}
+	areaId := *project.AreaId.String
+
+	organizationId, err := r.getOrganizationId(ctx, projectId)
+	if err != nil {
+		warnValidationSkipped(err)
+		return
+	}
+
+	areaPrefixes, err := iaasUtils.GetAreaPrefixes(ctx, r.client, organizationId, areaId)
+	if err != nil {
+		warnValidationSkipped(err)
+		return
+	}
+	err = areaPrefixes.CheckPrefix(prefix, stateModel.NetworkId.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ipv4_prefix"),
+			"Invalid network prefix",
+			fmt.Sprintf("The prefix can't be used in network area %q: %v", areaId, err),
+		)
+	}
+}
+
+// getOrganizationId returns the ID of the organization the project belongs to
+func (r *networkResource) getOrganizationId(ctx context.Context, projectId string) (string, error) {
+	project, err := r.resourceManagerClient.GetProject(ctx, projectId).IncludeParents(true).Execute()
+	if err != nil {
+		return "", fmt.Errorf("reading project parents: %w", err)
+	}
+	for _, parent := range project.GetParents() {
+		if parent.GetType() == resourcemanager.PARENTLISTINNERTYPE_ORGANIZATION {
+			return parent.GetId(), nil
+		}
+	}
+	return "", fmt.Errorf("organization of project %q not found", projectId)
+}
+
 func (r *networkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var resourceModel model.Model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &resourceModel)...)
@@ -134,7 +235,7 @@ func (r *networkResource) ValidateConfig(ctx context.Context, req resource.Valid
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring network", "Setting the `region` is not supported yet. This can only be configured when the experiments `network` is set.")
 		}
 		if !utils.IsUndefined(resourceModel.RoutingTableID) {
-			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring network", "Setting the field `routing_table_id` is not supported yet. This can only be configured when the experiments `network` is set.")
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring network", "Setting the field `routing_table_id` is not supported yet. This can only be configured when the experiments `network` is set. Use the `stackit_routing_table_association` resource to attach a routing table to the network instead.")
 		}
 	}
 }
@@ -217,6 +318,9 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:           true,
 				Computed:           true,
 				ElementType:        types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validate.IPv4(false)),
+				},
 			},
 			"no_ipv4_gateway": schema.BoolAttribute{
 				Description: "If set to `true`, the network doesn't have a gateway.",
@@ -230,7 +334,7 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
-					validate.IP(false),
+					validate.IPv4(false),
 				},
 			},
 			"ipv4_nameservers": schema.ListAttribute{
@@ -238,13 +342,16 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validate.IPv4(false)),
+				},
 			},
 			"ipv4_prefix": schema.StringAttribute{
-				Description: "The IPv4 prefix of the network (CIDR).",
+				Description: "The IPv4 prefix of the network (CIDR). If the project is part of a network area, the prefix is checked at plan time to be within the network ranges of the area and not to overlap with other networks of the area.",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
-					validate.CIDR(),
+					validate.CIDRv4(),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
@@ -287,7 +394,7 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
-					validate.IP(false),
+					validate.IPv6(false),
 				},
 			},
 			"ipv6_nameservers": schema.ListAttribute{
@@ -295,12 +402,15 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validate.IPv6(false)),
+				},
 			},
 			"ipv6_prefix": schema.StringAttribute{
 				Description: "The IPv6 prefix of the network (CIDR).",
 				Optional:    true,
 				Validators: []validator.String{
-					validate.CIDR(),
+					validate.CIDRv6(),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -0,0 +1,190 @@
+package networkarea
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const defaultLimit = 10
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &networkAreaFreePrefixesDataSource{}
+)
+
+// NewNetworkAreaFreePrefixesDataSource is a helper function to simplify the provider implementation.
+func NewNetworkAreaFreePrefixesDataSource() datasource.DataSource {
+	return &networkAreaFreePrefixesDataSource{}
+}
+
+// networkAreaFreePrefixesDataSource is the data source implementation.
+type networkAreaFreePrefixesDataSource struct {
+	client *iaas.APIClient
+}
+
+// FreePrefixesModel is the model of the network area free prefixes data source
+type FreePrefixesModel struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	OrganizationId types.String `tfsdk:"organization_id"`
+	NetworkAreaId  types.String `tfsdk:"network_area_id"`
+	PrefixLength   types.Int64  `tfsdk:"prefix_length"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	Prefixes       types.List   `tfsdk:"prefixes"`
+}
+
+// Metadata returns the data source type name.
+func (d *networkAreaFreePrefixesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_area_free_prefixes"
+}
+
+func (d *networkAreaFreePrefixesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *networkAreaFreePrefixesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Computes free IPv4 prefixes of a network area, i.e. prefixes within the network ranges of the area which are neither used by a network in one of its projects nor by the transfer network. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`organization_id`,`network_area_id`,`prefix_length`\".",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "STACKIT organization ID to which the network area is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"network_area_id": schema.StringAttribute{
+				Description: "The network area ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"prefix_length": schema.Int64Attribute{
+				Description: "The prefix length of the free prefixes. Must be within the minimum and maximum prefix length of the network area.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(8, 29),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of free prefixes to return. Defaults to `%d`.", defaultLimit),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"prefixes": schema.ListAttribute{
+				Description: "The free prefixes in ascending order.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *networkAreaFreePrefixesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model FreePrefixesModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	areaPrefixes, err := iaasUtils.GetAreaPrefixes(ctx, d.client, organizationId, networkAreaId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area free prefixes", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFreePrefixesFields(areaPrefixes, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area free prefixes", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area free prefixes read")
+}
+
+func mapFreePrefixesFields(areaPrefixes *iaasUtils.AreaPrefixes, model *FreePrefixesModel) error {
+	if areaPrefixes == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	prefixLength := model.PrefixLength.ValueInt64()
+	if areaPrefixes.MinPrefixLength != nil && prefixLength < *areaPrefixes.MinPrefixLength {
+		return fmt.Errorf("prefix length %d is smaller than the minimum prefix length %d of the network area", prefixLength, *areaPrefixes.MinPrefixLength)
+	}
+	if areaPrefixes.MaxPrefixLength != nil && prefixLength > *areaPrefixes.MaxPrefixLength {
+		return fmt.Errorf("prefix length %d is greater than the maximum prefix length %d of the network area", prefixLength, *areaPrefixes.MaxPrefixLength)
+	}
+
+	limit := int64(defaultLimit)
+	if !model.Limit.IsNull() && !model.Limit.IsUnknown() {
+		limit = model.Limit.ValueInt64()
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.OrganizationId.ValueString(), model.NetworkAreaId.ValueString(), strconv.FormatInt(prefixLength, 10))
+
+	freePrefixes := areaPrefixes.FreePrefixes(int(prefixLength), int(limit))
+	prefixesTF, diags := types.ListValue(types.StringType, prefixStrings(freePrefixes))
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Prefixes = prefixesTF
+	return nil
+}
+
+func prefixStrings(prefixes []netip.Prefix) []attr.Value {
+	values := make([]attr.Value, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		values = append(values, types.StringValue(prefix.String()))
+	}
+	return values
+}
@@ -0,0 +1,144 @@
+package networkarea
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+)
+
+func TestMapFreePrefixesFields(t *testing.T) {
+	areaPrefixes := &iaasUtils.AreaPrefixes{
+		Ranges:          []netip.Prefix{netip.MustParsePrefix("10.0.0.0/16")},
+		MinPrefixLength: utils.Ptr(int64(22)),
+		MaxPrefixLength: utils.Ptr(int64(28)),
+		Networks: []iaasUtils.AreaNetwork{
+			{
+				ProjectId: "pid",
+				NetworkId: "nid",
+				Prefixes:  []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24")},
+			},
+		},
+	}
+	tests := []struct {
+		description string
+		state       FreePrefixesModel
+		input       *iaasUtils.AreaPrefixes
+		expected    FreePrefixesModel
+		isValid     bool
+	}{
+		{
+			"default_limit",
+			FreePrefixesModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				PrefixLength:   types.Int64Value(22),
+			},
+			areaPrefixes,
+			FreePrefixesModel{
+				Id:             types.StringValue("oid,aid,22"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				PrefixLength:   types.Int64Value(22),
+				Prefixes: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("10.0.4.0/22"),
+					types.StringValue("10.0.8.0/22"),
+					types.StringValue("10.0.12.0/22"),
+					types.StringValue("10.0.16.0/22"),
+					types.StringValue("10.0.20.0/22"),
+					types.StringValue("10.0.24.0/22"),
+					types.StringValue("10.0.28.0/22"),
+					types.StringValue("10.0.32.0/22"),
+					types.StringValue("10.0.36.0/22"),
+					types.StringValue("10.0.40.0/22"),
+				}),
+			},
+			true,
+		},
+		{
+			"limit",
+			FreePrefixesModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				PrefixLength:   types.Int64Value(24),
+				Limit:          types.Int64Value(2),
+			},
+			areaPrefixes,
+			FreePrefixesModel{
+				Id:             types.StringValue("oid,aid,24"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				PrefixLength:   types.Int64Value(24),
+				Limit:          types.Int64Value(2),
+				Prefixes: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("10.0.1.0/24"),
+					types.StringValue("10.0.2.0/24"),
+				}),
+			},
+			true,
+		},
+		{
+			"no_free_prefixes",
+			FreePrefixesModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				PrefixLength:   types.Int64Value(24),
+			},
+			&iaasUtils.AreaPrefixes{},
+			FreePrefixesModel{
+				Id:             types.StringValue("oid,aid,24"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				PrefixLength:   types.Int64Value(24),
+				Prefixes:       types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"prefix_length_below_min",
+			FreePrefixesModel{
+				PrefixLength: types.Int64Value(16),
+			},
+			areaPrefixes,
+			FreePrefixesModel{},
+			false,
+		},
+		{
+			"prefix_length_above_max",
+			FreePrefixesModel{
+				PrefixLength: types.Int64Value(29),
+			},
+			areaPrefixes,
+			FreePrefixesModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			FreePrefixesModel{},
+			nil,
+			FreePrefixesModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFreePrefixesFields(tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -151,6 +151,9 @@ func (r *networkAreaResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "List of DNS Servers/Nameservers.",
 				Optional:    true,
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validate.IPv4(false)),
+				},
 			},
 			"network_ranges": schema.ListNestedAttribute{
 				Description: "List of Network ranges.",
@@ -171,6 +174,9 @@ func (r *networkAreaResource) Schema(_ context.Context, _ resource.SchemaRequest
 						"prefix": schema.StringAttribute{
 							Description: "Classless Inter-Domain Routing (CIDR).",
 							Required:    true,
+							Validators: []validator.String{
+								validate.CIDRv4(),
+							},
 						},
 					},
 				},
@@ -178,6 +184,9 @@ func (r *networkAreaResource) Schema(_ context.Context, _ resource.SchemaRequest
 			"transfer_network": schema.StringAttribute{
 				Description: "Classless Inter-Domain Routing (CIDR).",
 				Required:    true,
+				Validators: []validator.String{
+					validate.CIDRv4(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
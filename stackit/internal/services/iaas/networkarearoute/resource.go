@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"strings"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &networkAreaRouteResource{}
 	_ resource.ResourceWithConfigure   = &networkAreaRouteResource{}
 	_ resource.ResourceWithImportState = &networkAreaRouteResource{}
+	_ resource.ResourceWithModifyPlan  = &networkAreaRouteResource{}
 )
 
 type Model struct {
@@ -72,6 +74,36 @@ func (r *networkAreaRouteResource) Configure(ctx context.Context, req resource.C
 	tflog.Info(ctx, "IaaS client configured")
 }
 
+// ModifyPlan validates that the next hop is within the network ranges of the network area.
+func (r *networkAreaRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip initial empty configuration and destruction to avoid follow-up errors
+	if req.Config.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var configModel Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The next hop can't change without replacement, so it only has to be checked on creation
+	if !req.State.Raw.IsNull() {
+		return
+	}
+	if utils.IsUndefined(configModel.OrganizationId) || utils.IsUndefined(configModel.NetworkAreaId) || utils.IsUndefined(configModel.NextHop) {
+		return
+	}
+
+	networkRanges, err := getNetworkRanges(ctx, r.client, configModel.OrganizationId.ValueString(), configModel.NetworkAreaId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("next_hop"), "Next hop not validated", fmt.Sprintf("The network ranges of the network area could not be read: %v", err))
+		return
+	}
+	err = checkNextHopInNetworkRanges(configModel.NextHop.ValueString(), networkRanges)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("next_hop"), "Invalid route", err.Error())
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *networkAreaRouteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	description := "Network area route resource schema. Must have a `region` specified in the provider configuration."
@@ -122,23 +154,23 @@ func (r *networkAreaRouteResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"next_hop": schema.StringAttribute{
-				Description: "The IP address of the routing system, that will route the prefix configured. Should be a valid IPv4 address.",
+				Description: "The IP address of the routing system, that will route the prefix configured. Should be a valid IPv4 address within one of the network ranges of the network area.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					validate.IP(false),
+					validate.IPv4(false),
 				},
 			},
 			"prefix": schema.StringAttribute{
-				Description: "The network, that is reachable though the Next Hop. Should use CIDR notation.",
+				Description: "The network, that is reachable though the Next Hop. Should use IPv4 CIDR notation.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					validate.CIDR(),
+					validate.CIDRv4(),
 				},
 			},
 			"labels": schema.MapAttribute{
@@ -421,3 +453,36 @@ func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map)
 		Labels: &labels,
 	}, nil
 }
+
+// checkNextHopInNetworkRanges returns an error if the next hop is not within one of the network ranges.
+func checkNextHopInNetworkRanges(nextHop string, networkRanges []netip.Prefix) error {
+	parsedNextHop, err := netip.ParseAddr(nextHop)
+	if err != nil {
+		return fmt.Errorf("parsing next hop %q: %w", nextHop, err)
+	}
+	parsedNextHop = parsedNextHop.Unmap()
+	ranges := make([]string, 0, len(networkRanges))
+	for _, networkRange := range networkRanges {
+		if networkRange.Contains(parsedNextHop) {
+			return nil
+		}
+		ranges = append(ranges, networkRange.String())
+	}
+	return fmt.Errorf("next hop %s is not within the network ranges of the network area: %s", nextHop, strings.Join(ranges, ", "))
+}
+
+func getNetworkRanges(ctx context.Context, client *iaas.APIClient, organizationId, networkAreaId string) ([]netip.Prefix, error) {
+	rangesResp, err := client.ListNetworkAreaRangesExecute(ctx, organizationId, networkAreaId)
+	if err != nil {
+		return nil, fmt.Errorf("listing network ranges: %w", err)
+	}
+	networkRanges := []netip.Prefix{}
+	for _, networkRange := range rangesResp.GetItems() {
+		prefix, err := netip.ParsePrefix(networkRange.GetPrefix())
+		if err != nil {
+			return nil, fmt.Errorf("parsing network range %q: %w", networkRange.GetPrefix(), err)
+		}
+		networkRanges = append(networkRanges, prefix.Masked())
+	}
+	return networkRanges, nil
+}
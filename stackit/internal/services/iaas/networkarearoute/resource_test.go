@@ -2,6 +2,7 @@ package networkarearoute
 
 import (
 	"context"
+	"net/netip"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -203,3 +204,31 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckNextHopInNetworkRanges(t *testing.T) {
+	networkRanges := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/16"),
+		netip.MustParsePrefix("192.168.0.0/24"),
+	}
+	tests := []struct {
+		description string
+		nextHop     string
+		isValid     bool
+	}{
+		{"in_first_range", "10.0.12.1", true},
+		{"in_second_range", "192.168.0.254", true},
+		{"outside_ranges", "10.1.0.1", false},
+		{"invalid", "next-hop", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := checkNextHopInNetworkRanges(tt.nextHop, networkRanges)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
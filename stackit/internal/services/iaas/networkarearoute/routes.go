@@ -0,0 +1,609 @@
+package networkarearoute
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &networkAreaRoutesResource{}
+	_ resource.ResourceWithConfigure      = &networkAreaRoutesResource{}
+	_ resource.ResourceWithImportState    = &networkAreaRoutesResource{}
+	_ resource.ResourceWithValidateConfig = &networkAreaRoutesResource{}
+	_ resource.ResourceWithModifyPlan     = &networkAreaRoutesResource{}
+)
+
+type RoutesModel struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	OrganizationId types.String `tfsdk:"organization_id"`
+	NetworkAreaId  types.String `tfsdk:"network_area_id"`
+	Routes         types.Set    `tfsdk:"routes"`
+}
+
+// routeModel is a single element of the routes set
+type routeModel struct {
+	Prefix  types.String `tfsdk:"prefix"`
+	NextHop types.String `tfsdk:"next_hop"`
+	Labels  types.Map    `tfsdk:"labels"`
+}
+
+// Types corresponding to routeModel
+var routeTypes = map[string]attr.Type{
+	"prefix":   basetypes.StringType{},
+	"next_hop": basetypes.StringType{},
+	"labels":   basetypes.MapType{ElemType: types.StringType},
+}
+
+// NewNetworkAreaRoutesResource is a helper function to simplify the provider implementation.
+func NewNetworkAreaRoutesResource() resource.Resource {
+	return &networkAreaRoutesResource{}
+}
+
+// networkAreaRoutesResource is the resource implementation.
+type networkAreaRoutesResource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *networkAreaRoutesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_area_routes"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *networkAreaRoutesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "IaaS client configured")
+}
+
+func (r *networkAreaRoutesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model RoutesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Routes.IsNull() || model.Routes.IsUnknown() {
+		return
+	}
+	routes := []routeModel{}
+	resp.Diagnostics.Append(model.Routes.ElementsAs(ctx, &routes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := map[string]bool{}
+	for i := range routes {
+		route := &routes[i]
+		if utils.IsUndefined(route.Prefix) || utils.IsUndefined(route.NextHop) {
+			continue
+		}
+		key := routeKey(route.Prefix.ValueString(), route.NextHop.ValueString())
+		if keys[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("routes"),
+				"Duplicate route",
+				fmt.Sprintf("The route to %s via %s is configured more than once, e.g. with different labels.", route.Prefix.ValueString(), route.NextHop.ValueString()),
+			)
+		}
+		keys[key] = true
+	}
+}
+
+// ModifyPlan validates that the next hops of the routes are within the network ranges of the network area.
+func (r *networkAreaRoutesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip initial empty configuration and destruction to avoid follow-up errors
+	if req.Config.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var configModel RoutesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(configModel.OrganizationId) || utils.IsUndefined(configModel.NetworkAreaId) || utils.IsUndefined(configModel.Routes) {
+		return
+	}
+
+	routes := []routeModel{}
+	resp.Diagnostics.Append(configModel.Routes.ElementsAs(ctx, &routes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(routes) == 0 {
+		return
+	}
+
+	networkRanges, err := getNetworkRanges(ctx, r.client, configModel.OrganizationId.ValueString(), configModel.NetworkAreaId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(path.Root("routes"), "Next hops not validated", fmt.Sprintf("The network ranges of the network area could not be read: %v", err))
+		return
+	}
+	for i := range routes {
+		if utils.IsUndefined(routes[i].NextHop) {
+			continue
+		}
+		err = checkNextHopInNetworkRanges(routes[i].NextHop.ValueString(), networkRanges)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("routes"), "Invalid route", err.Error())
+		}
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *networkAreaRoutesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Network area routes resource schema. Manages all static routes of a network area authoritatively: routes which are not configured in `routes` are deleted, and routes added outside of Terraform are reported as drift. Must have a `region` specified in the provider configuration."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description + "\n\n" +
+			"~> Do not use this resource together with `stackit_network_area_route` resources for the same network area, they will delete each other's routes.",
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`organization_id`,`network_area_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "STACKIT organization ID to which the network area is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"network_area_id": schema.StringAttribute{
+				Description: "The network area ID to which the routes are associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"routes": schema.SetNestedAttribute{
+				Description: "The static routes of the network area. A route is identified by its `prefix` and `next_hop`, changing either of them replaces the route, while `labels` are updated in place.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"prefix": schema.StringAttribute{
+							Description: "The network, that is reachable though the Next Hop. Should use IPv4 CIDR notation.",
+							Required:    true,
+							Validators: []validator.String{
+								validate.CIDRv4(),
+							},
+						},
+						"next_hop": schema.StringAttribute{
+							Description: "The IP address of the routing system, that will route the prefix configured. Should be a valid IPv4 address within one of the network ranges of the network area.",
+							Required:    true,
+							Validators: []validator.String{
+								validate.IPv4(false),
+							},
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *networkAreaRoutesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model RoutesModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := model.OrganizationId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	err := r.reconcileRoutes(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area routes", fmt.Sprintf("Reconciling routes: %v", err))
+		return
+	}
+
+	model.Id = utils.BuildInternalTerraformId(organizationId, networkAreaId)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area routes created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *networkAreaRoutesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model RoutesModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	routesResp, err := r.client.ListNetworkAreaRoutesExecute(ctx, organizationId, networkAreaId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area routes", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapRoutesFields(ctx, routesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading network area routes", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area routes read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *networkAreaRoutesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model RoutesModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := model.OrganizationId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	err := r.reconcileRoutes(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area routes", fmt.Sprintf("Reconciling routes: %v", err))
+		return
+	}
+
+	model.Id = utils.BuildInternalTerraformId(organizationId, networkAreaId)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Network area routes updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// Only the routes which are known to the state are deleted.
+func (r *networkAreaRoutesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model RoutesModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	routesResp, err := r.client.ListNetworkAreaRoutesExecute(ctx, organizationId, networkAreaId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Network area already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area routes", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	managed, err := toRouteModels(ctx, model.Routes)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area routes", fmt.Sprintf("Processing state: %v", err))
+		return
+	}
+	managedKeys := map[string]bool{}
+	for i := range managed {
+		managedKeys[routeKey(managed[i].Prefix.ValueString(), managed[i].NextHop.ValueString())] = true
+	}
+	for _, route := range routesResp.GetItems() {
+		if route.RouteId == nil || !managedKeys[routeKey(route.GetPrefix(), route.GetNexthop())] {
+			continue
+		}
+		err = r.client.DeleteNetworkAreaRoute(ctx, organizationId, networkAreaId, *route.RouteId).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area routes", fmt.Sprintf("Deleting route %q: %v", *route.RouteId, err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Network area routes deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: organization_id,network_area_id
+func (r *networkAreaRoutesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing network area routes",
+			fmt.Sprintf("Expected import identifier with format: [organization_id],[network_area_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	organizationId := idParts[0]
+	networkAreaId := idParts[1]
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_area_id"), networkAreaId)...)
+	tflog.Info(ctx, "Network area routes state imported")
+}
+
+// reconcileRoutes lists the current routes of the network area and only deletes, updates and creates
+// the routes which differ from the planned ones.
+func (r *networkAreaRoutesResource) reconcileRoutes(ctx context.Context, model *RoutesModel) error {
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+
+	desired, err := toRouteModels(ctx, model.Routes)
+	if err != nil {
+		return fmt.Errorf("reading routes: %w", err)
+	}
+
+	routesResp, err := r.client.ListNetworkAreaRoutesExecute(ctx, organizationId, networkAreaId)
+	if err != nil {
+		return fmt.Errorf("listing current routes: %w", err)
+	}
+
+	toCreate, toUpdate, toDelete, err := diffRoutes(ctx, desired, routesResp.GetItems())
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, "network area routes diff", map[string]any{"create": len(toCreate), "update": len(toUpdate), "delete": len(toDelete)})
+
+	// Delete first, so that replaced routes don't collide with their successors
+	for _, routeId := range toDelete {
+		err = r.client.DeleteNetworkAreaRoute(ctx, organizationId, networkAreaId, routeId).Execute()
+		if err != nil {
+			return fmt.Errorf("deleting route %q: %w", routeId, err)
+		}
+	}
+	for routeId, payload := range toUpdate {
+		_, err = r.client.UpdateNetworkAreaRoute(ctx, organizationId, networkAreaId, routeId).UpdateNetworkAreaRoutePayload(*payload).Execute()
+		if err != nil {
+			return fmt.Errorf("updating route %q: %w", routeId, err)
+		}
+	}
+	if len(toCreate) > 0 {
+		_, err = r.client.CreateNetworkAreaRoute(ctx, organizationId, networkAreaId).CreateNetworkAreaRoutePayload(iaas.CreateNetworkAreaRoutePayload{
+			Ipv4: &toCreate,
+		}).Execute()
+		if err != nil {
+			return fmt.Errorf("creating routes: %w", err)
+		}
+	}
+	return nil
+}
+
+func toRouteModels(ctx context.Context, routesSet types.Set) ([]routeModel, error) {
+	routes := []routeModel{}
+	if routesSet.IsNull() || routesSet.IsUnknown() {
+		return routes, nil
+	}
+	diags := routesSet.ElementsAs(ctx, &routes, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	return routes, nil
+}
+
+// diffRoutes returns the routes which have to be created, the label updates per route ID and the IDs
+// of the routes which have to be deleted to get from the actual to the desired routes.
+func diffRoutes(ctx context.Context, desired []routeModel, actual []iaas.Route) (toCreate []iaas.Route, toUpdate map[string]*iaas.UpdateNetworkAreaRoutePayload, toDelete []string, err error) {
+	actualByKey := map[string]*iaas.Route{}
+	for i := range actual {
+		route := &actual[i]
+		key := routeKey(route.GetPrefix(), route.GetNexthop())
+		if _, ok := actualByKey[key]; ok && route.RouteId != nil {
+			// Duplicates of a route are removed
+			toDelete = append(toDelete, *route.RouteId)
+			continue
+		}
+		actualByKey[key] = route
+	}
+
+	toCreate = []iaas.Route{}
+	toUpdate = map[string]*iaas.UpdateNetworkAreaRoutePayload{}
+	matched := map[string]bool{}
+	for i := range desired {
+		route := &desired[i]
+		key := routeKey(route.Prefix.ValueString(), route.NextHop.ValueString())
+		matched[key] = true
+
+		actualRoute, ok := actualByKey[key]
+		if !ok {
+			labels, err := conversion.ToStringInterfaceMap(ctx, route.Labels)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("converting labels of route to %s: %w", route.Prefix.ValueString(), err)
+			}
+			toCreate = append(toCreate, iaas.Route{
+				Prefix:  conversion.StringValueToPointer(route.Prefix),
+				Nexthop: conversion.StringValueToPointer(route.NextHop),
+				Labels:  &labels,
+			})
+			continue
+		}
+
+		currentLabels, err := iaasUtils.MapLabels(ctx, actualRoute.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("mapping labels of route %q: %w", actualRoute.GetRouteId(), err)
+		}
+		if labelsEqual(currentLabels, route.Labels) || actualRoute.RouteId == nil {
+			continue
+		}
+		payload, err := toUpdatePayload(ctx, &Model{Labels: route.Labels}, currentLabels)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("creating update payload of route %q: %w", *actualRoute.RouteId, err)
+		}
+		toUpdate[*actualRoute.RouteId] = payload
+	}
+
+	for key, route := range actualByKey {
+		if !matched[key] && route.RouteId != nil {
+			toDelete = append(toDelete, *route.RouteId)
+		}
+	}
+	return toCreate, toUpdate, toDelete, nil
+}
+
+// labelsEqual compares labels, treating null and empty labels as equal
+func labelsEqual(a, b types.Map) bool {
+	if len(a.Elements()) == 0 && len(b.Elements()) == 0 {
+		return true
+	}
+	return a.Equal(b)
+}
+
+// routeKey identifies a route by its prefix and next hop, independent of their notation
+func routeKey(prefix, nextHop string) string {
+	if parsedPrefix, err := netip.ParsePrefix(prefix); err == nil {
+		prefix = parsedPrefix.Masked().String()
+	}
+	if parsedNextHop, err := netip.ParseAddr(nextHop); err == nil {
+		nextHop = parsedNextHop.Unmap().String()
+	}
+	return prefix + " via " + nextHop
+}
+
+// mapRoutesFields maps the actual routes of the network area to the routes set.
+// Routes which are part of the current set keep their configured notation,
+// all other routes are added as reported by the API so that they show up as drift.
+func mapRoutesFields(ctx context.Context, routesResp *iaas.RouteListResponse, model *RoutesModel) error {
+	if routesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.OrganizationId.ValueString(), model.NetworkAreaId.ValueString())
+
+	current, err := toRouteModels(ctx, model.Routes)
+	if err != nil {
+		return fmt.Errorf("reading routes: %w", err)
+	}
+	currentByKey := map[string]*routeModel{}
+	for i := range current {
+		currentByKey[routeKey(current[i].Prefix.ValueString(), current[i].NextHop.ValueString())] = &current[i]
+	}
+
+	mapped := map[string]bool{}
+	routes := []attr.Value{}
+	for i := range routesResp.GetItems() {
+		route := &routesResp.GetItems()[i]
+		key := routeKey(route.GetPrefix(), route.GetNexthop())
+		if mapped[key] {
+			continue
+		}
+		mapped[key] = true
+
+		routeData := &routeModel{
+			Prefix:  types.StringPointerValue(route.Prefix),
+			NextHop: types.StringPointerValue(route.Nexthop),
+			Labels:  types.MapNull(types.StringType),
+		}
+		if currentRoute, ok := currentByKey[key]; ok {
+			routeData.Prefix = currentRoute.Prefix
+			routeData.NextHop = currentRoute.NextHop
+			routeData.Labels = currentRoute.Labels
+		}
+		labels, err := iaasUtils.MapLabels(ctx, route.Labels, routeData.Labels)
+		if err != nil {
+			return fmt.Errorf("mapping labels of route %q: %w", route.GetRouteId(), err)
+		}
+		routeData.Labels = labels
+
+		routeValue, diags := types.ObjectValue(routeTypes, map[string]attr.Value{
+			"prefix":   routeData.Prefix,
+			"next_hop": routeData.NextHop,
+			"labels":   routeData.Labels,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("create route object: %w", core.DiagsToError(diags))
+		}
+		routes = append(routes, routeValue)
+	}
+
+	routesSet, diags := types.SetValue(types.ObjectType{AttrTypes: routeTypes}, routes)
+	if diags.HasError() {
+		return fmt.Errorf("create routes set: %w", core.DiagsToError(diags))
+	}
+	model.Routes = routesSet
+	return nil
+}
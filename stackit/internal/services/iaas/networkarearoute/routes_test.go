@@ -0,0 +1,288 @@
+package networkarearoute
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func routeValue(prefix, nextHop string, labels types.Map) attr.Value {
+	return types.ObjectValueMust(routeTypes, map[string]attr.Value{
+		"prefix":   types.StringValue(prefix),
+		"next_hop": types.StringValue(nextHop),
+		"labels":   labels,
+	})
+}
+
+func TestRouteKey(t *testing.T) {
+	tests := []struct {
+		description string
+		prefixA     string
+		nextHopA    string
+		prefixB     string
+		nextHopB    string
+		expected    bool
+	}{
+		{"same", "10.0.0.0/24", "10.1.0.1", "10.0.0.0/24", "10.1.0.1", true},
+		{"unmasked_prefix", "10.0.0.1/24", "10.1.0.1", "10.0.0.0/24", "10.1.0.1", true},
+		{"mapped_next_hop", "10.0.0.0/24", "::ffff:10.1.0.1", "10.0.0.0/24", "10.1.0.1", true},
+		{"different_next_hop", "10.0.0.0/24", "10.1.0.1", "10.0.0.0/24", "10.1.0.2", false},
+		{"different_prefix_length", "10.0.0.0/24", "10.1.0.1", "10.0.0.0/25", "10.1.0.1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := routeKey(tt.prefixA, tt.nextHopA) == routeKey(tt.prefixB, tt.nextHopB)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestDiffRoutes(t *testing.T) {
+	tests := []struct {
+		description      string
+		desired          []routeModel
+		actual           []iaas.Route
+		expectedCreate   []iaas.Route
+		expectedUpdate   map[string]*iaas.UpdateNetworkAreaRoutePayload
+		expectedDeletion []string
+	}{
+		{
+			"no_changes",
+			[]routeModel{
+				{
+					Prefix:  types.StringValue("10.0.0.0/24"),
+					NextHop: types.StringValue("10.1.0.1"),
+					Labels:  types.MapNull(types.StringType),
+				},
+			},
+			[]iaas.Route{
+				{
+					RouteId: utils.Ptr("rid"),
+					Prefix:  utils.Ptr("10.0.0.0/24"),
+					Nexthop: utils.Ptr("10.1.0.1"),
+					Labels:  &map[string]interface{}{},
+				},
+			},
+			[]iaas.Route{},
+			map[string]*iaas.UpdateNetworkAreaRoutePayload{},
+			nil,
+		},
+		{
+			"create_update_delete",
+			[]routeModel{
+				{
+					Prefix:  types.StringValue("10.0.0.0/24"),
+					NextHop: types.StringValue("10.1.0.1"),
+					Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+						"key": types.StringValue("value"),
+					}),
+				},
+				{
+					Prefix:  types.StringValue("10.2.0.0/24"),
+					NextHop: types.StringValue("10.1.0.3"),
+					Labels:  types.MapNull(types.StringType),
+				},
+			},
+			[]iaas.Route{
+				{
+					RouteId: utils.Ptr("rid-1"),
+					Prefix:  utils.Ptr("10.0.0.0/24"),
+					Nexthop: utils.Ptr("10.1.0.1"),
+					Labels:  &map[string]interface{}{"old": "value"},
+				},
+				{
+					RouteId: utils.Ptr("rid-2"),
+					Prefix:  utils.Ptr("10.0.0.0/24"),
+					Nexthop: utils.Ptr("10.1.0.2"),
+				},
+			},
+			[]iaas.Route{
+				{
+					Prefix:  utils.Ptr("10.2.0.0/24"),
+					Nexthop: utils.Ptr("10.1.0.3"),
+					Labels:  &map[string]interface{}{},
+				},
+			},
+			map[string]*iaas.UpdateNetworkAreaRoutePayload{
+				"rid-1": {
+					Labels: &map[string]interface{}{
+						"key": "value",
+						"old": nil,
+					},
+				},
+			},
+			[]string{"rid-2"},
+		},
+		{
+			"duplicates_deleted",
+			[]routeModel{
+				{
+					Prefix:  types.StringValue("10.0.0.0/24"),
+					NextHop: types.StringValue("10.1.0.1"),
+					Labels:  types.MapNull(types.StringType),
+				},
+			},
+			[]iaas.Route{
+				{
+					RouteId: utils.Ptr("rid-1"),
+					Prefix:  utils.Ptr("10.0.0.0/24"),
+					Nexthop: utils.Ptr("10.1.0.1"),
+				},
+				{
+					RouteId: utils.Ptr("rid-2"),
+					Prefix:  utils.Ptr("10.0.0.0/24"),
+					Nexthop: utils.Ptr("10.1.0.1"),
+				},
+			},
+			[]iaas.Route{},
+			map[string]*iaas.UpdateNetworkAreaRoutePayload{},
+			[]string{"rid-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			toCreate, toUpdate, toDelete, err := diffRoutes(context.Background(), tt.desired, tt.actual)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(toCreate, tt.expectedCreate)
+			if diff != "" {
+				t.Fatalf("Routes to create do not match: %s", diff)
+			}
+			diff = cmp.Diff(toUpdate, tt.expectedUpdate)
+			if diff != "" {
+				t.Fatalf("Routes to update do not match: %s", diff)
+			}
+			slices.Sort(toDelete)
+			diff = cmp.Diff(toDelete, tt.expectedDeletion)
+			if diff != "" {
+				t.Fatalf("Routes to delete do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapRoutesFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       RoutesModel
+		input       *iaas.RouteListResponse
+		expected    RoutesModel
+		isValid     bool
+	}{
+		{
+			"configured_notation_kept",
+			RoutesModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes: types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, []attr.Value{
+					routeValue("10.2.0.1/24", "10.1.0.3", types.MapNull(types.StringType)),
+				}),
+			},
+			&iaas.RouteListResponse{
+				Items: &[]iaas.Route{
+					{
+						RouteId: utils.Ptr("rid"),
+						Prefix:  utils.Ptr("10.2.0.0/24"),
+						Nexthop: utils.Ptr("10.1.0.3"),
+					},
+				},
+			},
+			RoutesModel{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes: types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, []attr.Value{
+					routeValue("10.2.0.1/24", "10.1.0.3", types.MapNull(types.StringType)),
+				}),
+			},
+			true,
+		},
+		{
+			"unmanaged_route_and_label_drift",
+			RoutesModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes: types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, []attr.Value{
+					routeValue("10.0.0.0/24", "10.1.0.1", types.MapNull(types.StringType)),
+				}),
+			},
+			&iaas.RouteListResponse{
+				Items: &[]iaas.Route{
+					{
+						RouteId: utils.Ptr("rid-1"),
+						Prefix:  utils.Ptr("10.0.0.0/24"),
+						Nexthop: utils.Ptr("10.1.0.1"),
+						Labels:  &map[string]interface{}{"key": "value"},
+					},
+					{
+						RouteId: utils.Ptr("rid-2"),
+						Prefix:  utils.Ptr("10.2.0.0/24"),
+						Nexthop: utils.Ptr("10.1.0.2"),
+					},
+				},
+			},
+			RoutesModel{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes: types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, []attr.Value{
+					routeValue("10.0.0.0/24", "10.1.0.1", types.MapValueMust(types.StringType, map[string]attr.Value{
+						"key": types.StringValue("value"),
+					})),
+					routeValue("10.2.0.0/24", "10.1.0.2", types.MapNull(types.StringType)),
+				}),
+			},
+			true,
+		},
+		{
+			"no_routes",
+			RoutesModel{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+			},
+			&iaas.RouteListResponse{
+				Items: &[]iaas.Route{},
+			},
+			RoutesModel{
+				Id:             types.StringValue("oid,naid"),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("naid"),
+				Routes:         types.SetValueMust(types.ObjectType{AttrTypes: routeTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			RoutesModel{},
+			nil,
+			RoutesModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapRoutesFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -104,7 +104,11 @@ func (d *networkInterfaceDataSource) Schema(_ context.Context, _ datasource.Sche
 				Computed:    true,
 			},
 			"ipv4": schema.StringAttribute{
-				Description: "The IPv4 address.",
+				Description: "The fixed IPv4 address.",
+				Computed:    true,
+			},
+			"ipv6": schema.StringAttribute{
+				Description: "The fixed IPv6 address.",
 				Computed:    true,
 			},
 			"labels": schema.MapAttribute{
@@ -3,6 +3,7 @@ package networkinterface
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"regexp"
 	"strings"
@@ -44,6 +45,7 @@ type Model struct {
 	Name               types.String `tfsdk:"name"`
 	AllowedAddresses   types.List   `tfsdk:"allowed_addresses"`
 	IPv4               types.String `tfsdk:"ipv4"`
+	IPv6               types.String `tfsdk:"ipv6"`
 	Labels             types.Map    `tfsdk:"labels"`
 	Security           types.Bool   `tfsdk:"security"`
 	SecurityGroupIds   types.List   `tfsdk:"security_group_ids"`
@@ -92,6 +94,51 @@ func (r *networkInterfaceResource) ModifyPlan(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	var stateModel Model
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.validateAddressesInNetwork(ctx, &configModel, &stateModel, &resp.Diagnostics)
+}
+
+// validateAddressesInNetwork checks at plan time that new fixed IPs are part of the prefixes of the network.
+// If the network can't be read, e.g. because it's created in the same apply, the check is left to the API.
+func (r *networkInterfaceResource) validateAddressesInNetwork(ctx context.Context, configModel, stateModel *Model, diags *diag.Diagnostics) {
+	if r.client == nil || configModel.NetworkId.IsUnknown() || configModel.ProjectId.IsUnknown() {
+		return
+	}
+
+	checks := map[string]types.String{}
+	if !(configModel.IPv4.IsNull() || configModel.IPv4.IsUnknown()) && !configModel.IPv4.Equal(stateModel.IPv4) {
+		checks["ipv4"] = configModel.IPv4
+	}
+	if !(configModel.IPv6.IsNull() || configModel.IPv6.IsUnknown()) && !configModel.IPv6.Equal(stateModel.IPv6) {
+		checks["ipv6"] = configModel.IPv6
+	}
+	if len(checks) == 0 {
+		return
+	}
+
+	network, err := r.client.GetNetworkExecute(ctx, configModel.ProjectId.ValueString(), configModel.NetworkId.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping validation of the network interface IP addresses, reading network: %v", err))
+		return
+	}
+
+	if address, ok := checks["ipv4"]; ok {
+		if err := checkAddressInPrefixes(address.ValueString(), network.GetPrefixes()); err != nil {
+			diags.AddAttributeError(path.Root("ipv4"), "Invalid network interface IPv4 address", err.Error())
+		}
+	}
+	if address, ok := checks["ipv6"]; ok {
+		if err := checkAddressInPrefixes(address.ValueString(), network.GetPrefixesV6()); err != nil {
+			diags.AddAttributeError(path.Root("ipv6"), "Invalid network interface IPv6 address", err.Error())
+		}
+	}
 }
 
 // Metadata returns the resource type name.
@@ -120,8 +167,10 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 	description := "Network interface resource schema. Must have a `region` specified in the provider configuration."
 
 	resp.Schema = schema.Schema{
-		MarkdownDescription: description,
-		Description:         description,
+		MarkdownDescription: description + "\n\n" +
+			"A network interface has at most one fixed IPv4 and one fixed IPv6 address. " +
+			"Additional addresses, e.g. the virtual IP of a VRRP/keepalived pair, have to be added to `allowed_addresses` of every interface which may hold them.",
+		Description: description,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`network_id`,`network_interface_id`\".",
@@ -180,7 +229,7 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"allowed_addresses": schema.ListAttribute{
-				Description: "The list of CIDR (Classless Inter-Domain Routing) notations.",
+				Description: "The list of CIDR (Classless Inter-Domain Routing) notations, IPv4 and IPv6, of addresses which may be used by the network interface in addition to its fixed IPs, e.g. virtual IPs of VRRP/keepalived pairs.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
@@ -195,11 +244,23 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:    true,
 			},
 			"ipv4": schema.StringAttribute{
-				Description: "The IPv4 address.",
+				Description: "The fixed IPv4 address. Must be part of the IPv4 prefixes of the network. The API supports a single fixed IPv4 address per network interface, additional addresses have to be added to `allowed_addresses`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.IPv4(false),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv6": schema.StringAttribute{
+				Description: "The fixed IPv6 address. Must be part of the IPv6 prefixes of the network. Only available if the network has IPv6 prefixes. The API supports a single fixed IPv6 address per network interface, additional addresses have to be added to `allowed_addresses`.",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
-					validate.IP(false),
+					validate.IPv6(false),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -523,6 +584,7 @@ func mapFields(ctx context.Context, networkInterfaceResp *iaas.NIC, model *Model
 	model.NetworkInterfaceId = types.StringValue(networkInterfaceId)
 	model.Name = networkInterfaceName
 	model.IPv4 = types.StringPointerValue(networkInterfaceResp.Ipv4)
+	model.IPv6 = types.StringPointerValue(networkInterfaceResp.Ipv6)
 	model.Security = types.BoolPointerValue(networkInterfaceResp.NicSecurity)
 	model.Device = types.StringPointerValue(networkInterfaceResp.Device)
 	model.Mac = types.StringPointerValue(networkInterfaceResp.Mac)
@@ -581,6 +643,7 @@ func toCreatePayload(ctx context.Context, model *Model) (*iaas.CreateNicPayload,
 		Name:             conversion.StringValueToPointer(model.Name),
 		Device:           conversion.StringValueToPointer(model.Device),
 		Ipv4:             conversion.StringValueToPointer(model.IPv4),
+		Ipv6:             conversion.StringValueToPointer(model.IPv6),
 		Mac:              conversion.StringValueToPointer(model.Mac),
 		Type:             conversion.StringValueToPointer(model.Type),
 		NicSecurity:      conversion.BoolValueToPointer(model.Security),
@@ -633,3 +696,24 @@ func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map)
 		NicSecurity:      conversion.BoolValueToPointer(model.Security),
 	}, nil
 }
+
+// checkAddressInPrefixes returns an error if the address is not part of any of the prefixes.
+func checkAddressInPrefixes(address string, prefixes []string) error {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return fmt.Errorf("%q is not a valid IP address", address)
+	}
+	if len(prefixes) == 0 {
+		return fmt.Errorf("the network has no prefixes for the address family of %s", address)
+	}
+	for _, prefix := range prefixes {
+		_, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return fmt.Errorf("parsing network prefix %q: %w", prefix, err)
+		}
+		if ipNet.Contains(ip) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not part of the network prefixes %s", address, strings.Join(prefixes, ", "))
+}
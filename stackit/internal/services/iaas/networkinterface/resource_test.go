@@ -90,6 +90,7 @@ func TestMapFields(t *testing.T) {
 					types.StringValue("prefix2"),
 				}),
 				IPv4:     types.StringValue("ipv4"),
+				IPv6:     types.StringValue("ipv6"),
 				Security: types.BoolValue(true),
 				Device:   types.StringValue("device"),
 				Mac:      types.StringValue("mac"),
@@ -246,6 +247,34 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dual_stack",
+			&Model{
+				Name:             types.StringValue("name"),
+				SecurityGroupIds: types.ListNull(types.StringType),
+				AllowedAddresses: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("10.0.0.100/32"),
+					types.StringValue("2001:db8::100/128"),
+				}),
+				IPv4: types.StringValue("10.0.0.10"),
+				IPv6: types.StringValue("2001:db8::10"),
+			},
+			&iaas.CreateNicPayload{
+				Name:           utils.Ptr("name"),
+				SecurityGroups: &[]string{},
+				AllowedAddresses: &[]iaas.AllowedAddressesInner{
+					{
+						String: utils.Ptr("10.0.0.100/32"),
+					},
+					{
+						String: utils.Ptr("2001:db8::100/128"),
+					},
+				},
+				Ipv4: utils.Ptr("10.0.0.10"),
+				Ipv6: utils.Ptr("2001:db8::10"),
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
@@ -341,3 +370,32 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckAddressInPrefixes(t *testing.T) {
+	tests := []struct {
+		description string
+		address     string
+		prefixes    []string
+		isValid     bool
+	}{
+		{"ipv4_in_prefix", "10.0.0.10", []string{"10.0.0.0/24"}, true},
+		{"ipv4_in_second_prefix", "10.0.1.10", []string{"10.0.0.0/24", "10.0.1.0/24"}, true},
+		{"ipv4_outside_prefix", "10.0.1.10", []string{"10.0.0.0/24"}, false},
+		{"ipv6_in_prefix", "2001:db8::10", []string{"2001:db8::/64"}, true},
+		{"ipv6_outside_prefix", "2001:db9::10", []string{"2001:db8::/64"}, false},
+		{"no_prefixes", "2001:db8::10", nil, false},
+		{"invalid_address", "not-an-ip", []string{"10.0.0.0/24"}, false},
+		{"invalid_prefix", "10.0.0.10", []string{"10.0.0.0"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := checkAddressInPrefixes(tt.address, tt.prefixes)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package publicip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &publicIpsDataSource{}
+)
+
+// NewPublicIpsDataSource is a helper function to simplify the provider implementation.
+func NewPublicIpsDataSource() datasource.DataSource {
+	return &publicIpsDataSource{}
+}
+
+// publicIpsDataSource is the data source implementation.
+type publicIpsDataSource struct {
+	client *iaas.APIClient
+}
+
+// PublicIpsModel is the model of the public IPs data source
+type PublicIpsModel struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	ProjectId          types.String `tfsdk:"project_id"`
+	LabelSelector      types.String `tfsdk:"label_selector"`
+	Associated         types.Bool   `tfsdk:"associated"`
+	NetworkInterfaceId types.String `tfsdk:"network_interface_id"`
+	PublicIps          types.List   `tfsdk:"public_ips"`
+}
+
+var publicIpTypes = map[string]attr.Type{
+	"public_ip_id":         types.StringType,
+	"ip":                   types.StringType,
+	"network_interface_id": types.StringType,
+	"labels":               types.MapType{ElemType: types.StringType},
+}
+
+// Metadata returns the data source type name.
+func (d *publicIpsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_ips"
+}
+
+func (d *publicIpsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *publicIpsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Lists the public IPs of a project, optionally filtered by labels and association. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to list the public IPs of.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"label_selector": schema.StringAttribute{
+				Description: "Label selector to filter public IPs by, e.g. `env=prod,role=egress`.",
+				Optional:    true,
+			},
+			"associated": schema.BoolAttribute{
+				Description: "If set to `true`, only public IPs associated with a network interface are returned. If set to `false`, only unassociated public IPs are returned.",
+				Optional:    true,
+			},
+			"network_interface_id": schema.StringAttribute{
+				Description: "If set, only public IPs associated with this network interface are returned.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"public_ips": schema.ListNestedAttribute{
+				Description: "The public IPs matching the filters, sorted by IP address.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"public_ip_id": schema.StringAttribute{
+							Description: "The public IP ID.",
+							Computed:    true,
+						},
+						"ip": schema.StringAttribute{
+							Description: "The IP address.",
+							Computed:    true,
+						},
+						"network_interface_id": schema.StringAttribute{
+							Description: "The network interface or virtual IP (ID) the public IP is associated with.",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "Labels are key-value string pairs which can be attached to a resource container",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *publicIpsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model PublicIpsModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	labelSelector := model.LabelSelector.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "label_selector", labelSelector)
+
+	listReq := d.client.ListPublicIPs(ctx, projectId)
+	if labelSelector != "" {
+		listReq = listReq.LabelSelector(labelSelector)
+	}
+	publicIpsResp, err := listReq.Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading public IPs",
+			fmt.Sprintf("Public IPs could not be listed in project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response body to schema
+	err = mapPublicIpsFields(ctx, publicIpsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public IPs", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "public IPs read")
+}
+
+func mapPublicIpsFields(ctx context.Context, publicIpsResp *iaas.PublicIpListResponse, model *PublicIpsModel) error {
+	if publicIpsResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+
+	publicIps := filterPublicIps(publicIpsResp.GetItems(), model.Associated, model.NetworkInterfaceId.ValueString())
+
+	publicIpsList := make([]attr.Value, 0, len(publicIps))
+	for i := range publicIps {
+		publicIp := &publicIps[i]
+		labels, err := iaasUtils.MapLabels(ctx, publicIp.Labels, types.MapNull(types.StringType))
+		if err != nil {
+			return fmt.Errorf("mapping labels of public IP %q: %w", publicIp.GetId(), err)
+		}
+		publicIpValues := map[string]attr.Value{
+			"public_ip_id":         types.StringPointerValue(publicIp.Id),
+			"ip":                   types.StringPointerValue(publicIp.Ip),
+			"network_interface_id": types.StringPointerValue(publicIp.GetNetworkInterface()),
+			"labels":               labels,
+		}
+		publicIpObject, diags := types.ObjectValue(publicIpTypes, publicIpValues)
+		if diags.HasError() {
+			return fmt.Errorf("mapping public IP %q: %w", publicIp.GetId(), core.DiagsToError(diags))
+		}
+		publicIpsList = append(publicIpsList, publicIpObject)
+	}
+
+	publicIpsTF, diags := types.ListValue(types.ObjectType{AttrTypes: publicIpTypes}, publicIpsList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.PublicIps = publicIpsTF
+	return nil
+}
+
+// filterPublicIps returns the public IPs matching the association filters, sorted by IP address
+// to prevent unnecessary changes of dependent resources due to order changes.
+func filterPublicIps(publicIps []iaas.PublicIp, associated types.Bool, networkInterfaceId string) []iaas.PublicIp {
+	filtered := make([]iaas.PublicIp, 0, len(publicIps))
+	for i := range publicIps {
+		publicIp := publicIps[i]
+		publicIpNetworkInterfaceId := publicIp.GetNetworkInterface()
+		isAssociated := publicIpNetworkInterfaceId != nil && *publicIpNetworkInterfaceId != ""
+		if !associated.IsNull() && !associated.IsUnknown() && associated.ValueBool() != isAssociated {
+			continue
+		}
+		if networkInterfaceId != "" && (!isAssociated || *publicIpNetworkInterfaceId != networkInterfaceId) {
+			continue
+		}
+		filtered = append(filtered, publicIp)
+	}
+
+	slices.SortStableFunc(filtered, func(a, b iaas.PublicIp) int {
+		ipA, errA := netip.ParseAddr(a.GetIp())
+		ipB, errB := netip.ParseAddr(b.GetIp())
+		if errA != nil || errB != nil {
+			return strings.Compare(a.GetIp(), b.GetIp())
+		}
+		return ipA.Compare(ipB)
+	})
+	return filtered
+}
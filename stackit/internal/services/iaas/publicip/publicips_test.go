@@ -0,0 +1,150 @@
+package publicip
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapPublicIpsFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       PublicIpsModel
+		input       *iaas.PublicIpListResponse
+		expected    PublicIpsModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			PublicIpsModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.PublicIpListResponse{
+				Items: &[]iaas.PublicIp{},
+			},
+			PublicIpsModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				PublicIps: types.ListValueMust(types.ObjectType{AttrTypes: publicIpTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			PublicIpsModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.PublicIpListResponse{
+				Items: &[]iaas.PublicIp{
+					{
+						Id: utils.Ptr("pipid-2"),
+						Ip: utils.Ptr("10.0.0.2"),
+						Labels: &map[string]interface{}{
+							"key": "value",
+						},
+						NetworkInterface: iaas.NewNullableString(utils.Ptr("interface")),
+					},
+					{
+						Id:               utils.Ptr("pipid-1"),
+						Ip:               utils.Ptr("10.0.0.1"),
+						NetworkInterface: iaas.NewNullableString(nil),
+					},
+				},
+			},
+			PublicIpsModel{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				PublicIps: types.ListValueMust(types.ObjectType{AttrTypes: publicIpTypes}, []attr.Value{
+					types.ObjectValueMust(publicIpTypes, map[string]attr.Value{
+						"public_ip_id":         types.StringValue("pipid-1"),
+						"ip":                   types.StringValue("10.0.0.1"),
+						"network_interface_id": types.StringNull(),
+						"labels":               types.MapNull(types.StringType),
+					}),
+					types.ObjectValueMust(publicIpTypes, map[string]attr.Value{
+						"public_ip_id":         types.StringValue("pipid-2"),
+						"ip":                   types.StringValue("10.0.0.2"),
+						"network_interface_id": types.StringValue("interface"),
+						"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"key": types.StringValue("value"),
+						}),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			PublicIpsModel{},
+			nil,
+			PublicIpsModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapPublicIpsFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterPublicIps(t *testing.T) {
+	publicIps := []iaas.PublicIp{
+		{
+			Id:               utils.Ptr("pipid-3"),
+			Ip:               utils.Ptr("10.0.0.10"),
+			NetworkInterface: iaas.NewNullableString(utils.Ptr("interface-1")),
+		},
+		{
+			Id: utils.Ptr("pipid-2"),
+			Ip: utils.Ptr("10.0.0.9"),
+		},
+		{
+			Id:               utils.Ptr("pipid-1"),
+			Ip:               utils.Ptr("10.0.0.1"),
+			NetworkInterface: iaas.NewNullableString(utils.Ptr("interface-2")),
+		},
+	}
+	tests := []struct {
+		description        string
+		associated         types.Bool
+		networkInterfaceId string
+		expected           []string
+	}{
+		{"no_filter", types.BoolNull(), "", []string{"pipid-1", "pipid-2", "pipid-3"}},
+		{"associated", types.BoolValue(true), "", []string{"pipid-1", "pipid-3"}},
+		{"unassociated", types.BoolValue(false), "", []string{"pipid-2"}},
+		{"network_interface", types.BoolNull(), "interface-1", []string{"pipid-3"}},
+		{"unassociated_network_interface", types.BoolValue(false), "interface-1", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := filterPublicIps(publicIps, tt.associated, tt.networkInterfaceId)
+			outputIds := []string{}
+			for _, publicIp := range output {
+				outputIds = append(outputIds, publicIp.GetId())
+			}
+			diff := cmp.Diff(outputIds, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
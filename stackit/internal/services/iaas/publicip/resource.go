@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
+	"slices"
 	"strings"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -27,11 +31,16 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &publicIpResource{}
-	_ resource.ResourceWithConfigure   = &publicIpResource{}
-	_ resource.ResourceWithImportState = &publicIpResource{}
+	_ resource.Resource                   = &publicIpResource{}
+	_ resource.ResourceWithConfigure      = &publicIpResource{}
+	_ resource.ResourceWithImportState    = &publicIpResource{}
+	_ resource.ResourceWithValidateConfig = &publicIpResource{}
 )
 
+// claimLabel marks the public IPs of resources with adopt_existing, so that a public IP is never adopted by two resources.
+// Its value identifies the claiming resource. The label is managed by the provider and not part of the labels in the state.
+const claimLabel = "stackit-terraform-claim"
+
 type Model struct {
 	Id                 types.String `tfsdk:"id"` // needed by TF
 	ProjectId          types.String `tfsdk:"project_id"`
@@ -41,6 +50,12 @@ type Model struct {
 	Labels             types.Map    `tfsdk:"labels"`
 }
 
+type ResourceModel struct {
+	Model
+	AdoptExisting  types.Bool `tfsdk:"adopt_existing"`
+	RetainOnDelete types.Bool `tfsdk:"retain_on_delete"`
+}
+
 // NewPublicIpResource is a helper function to simplify the provider implementation.
 func NewPublicIpResource() resource.Resource {
 	return &publicIpResource{}
@@ -71,6 +86,25 @@ func (r *publicIpResource) Configure(ctx context.Context, req resource.Configure
 	tflog.Info(ctx, "iaas client configured")
 }
 
+func (r *publicIpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !model.AdoptExisting.ValueBool() || model.Labels.IsUnknown() {
+		return
+	}
+	if model.Labels.IsNull() || len(model.Labels.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("labels"),
+			"Missing attribute configuration",
+			"`labels` must be set if `adopt_existing` is `true`, they are used to find the public IP to adopt.",
+		)
+	}
+}
+
 // Schema defines the schema for the resource.
 func (r *publicIpResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	description := "Public IP resource schema. Must have a `region` specified in the provider configuration."
@@ -131,6 +165,18 @@ func (r *publicIpResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "If set to `true`, an existing public IP of the project which has all of the `labels`, is not associated with a network interface and is not adopted by another resource is used instead of allocating a new one. A new public IP is only allocated if there is none. The public IP is claimed with the `" + claimLabel + "` label until it is destroyed or retained. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"retain_on_delete": schema.BoolAttribute{
+				Description: "If set to `true`, the public IP is only disassociated instead of released when the resource is destroyed, and its claim is released, so that it can be adopted again with `adopt_existing`. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -138,7 +184,7 @@ func (r *publicIpResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 // Create creates the resource and sets the initial Terraform state.
 func (r *publicIpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -148,25 +194,39 @@ func (r *publicIpResource) Create(ctx context.Context, req resource.CreateReques
 	projectId := model.ProjectId.ValueString()
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 
-	// Generate API request body from model
-	payload, err := toCreatePayload(ctx, &model)
-	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating public IP", fmt.Sprintf("Creating API payload: %v", err))
-		return
+	var publicIp *iaas.PublicIp
+	var err error
+	if model.AdoptExisting.ValueBool() {
+		publicIp, err = r.adoptPublicIp(ctx, &model.Model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating public IP", fmt.Sprintf("Adopting existing public IP: %v", err))
+			return
+		}
 	}
 
-	// Create new public IP
+	if publicIp == nil {
+		// Generate API request body from model
+		payload, err := toCreatePayload(ctx, &model.Model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating public IP", fmt.Sprintf("Creating API payload: %v", err))
+			return
+		}
+		if model.AdoptExisting.ValueBool() {
+			(*payload.Labels)[claimLabel] = uuid.NewString()
+		}
 
-	publicIp, err := r.client.CreatePublicIP(ctx, projectId).CreatePublicIPPayload(*payload).Execute()
-	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating public IP", fmt.Sprintf("Calling API: %v", err))
-		return
+		// Create new public IP
+		publicIp, err = r.client.CreatePublicIP(ctx, projectId).CreatePublicIPPayload(*payload).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating public IP", fmt.Sprintf("Calling API: %v", err))
+			return
+		}
 	}
 
 	ctx = tflog.SetField(ctx, "public_ip_id", *publicIp.Id)
 
 	// Map response body to schema
-	err = mapFields(ctx, publicIp, &model)
+	err = mapFields(ctx, publicIp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating public IP", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -182,7 +242,7 @@ func (r *publicIpResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *publicIpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -205,11 +265,20 @@ func (r *publicIpResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Map response body to schema
-	err = mapFields(ctx, publicIpResp, &model)
+	err = mapFields(ctx, publicIpResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading public IP", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+
+	// Public IPs created before adopt_existing and retain_on_delete were introduced have no value in the state
+	if model.AdoptExisting.IsNull() {
+		model.AdoptExisting = types.BoolValue(false)
+	}
+	if model.RetainOnDelete.IsNull() {
+		model.RetainOnDelete = types.BoolValue(false)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -222,7 +291,7 @@ func (r *publicIpResource) Read(ctx context.Context, req resource.ReadRequest, r
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *publicIpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -234,7 +303,7 @@ func (r *publicIpResource) Update(ctx context.Context, req resource.UpdateReques
 	ctx = tflog.SetField(ctx, "public_ip_id", publicIpId)
 
 	// Retrieve values from state
-	var stateModel Model
+	var stateModel ResourceModel
 	diags = req.State.Get(ctx, &stateModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -242,7 +311,7 @@ func (r *publicIpResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Generate API request body from model
-	payload, err := toUpdatePayload(ctx, &model, stateModel.Labels)
+	payload, err := toUpdatePayload(ctx, &model.Model, stateModel.Labels)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating public IP", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -254,7 +323,7 @@ func (r *publicIpResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	err = mapFields(ctx, updatedPublicIp, &model)
+	err = mapFields(ctx, updatedPublicIp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating public IP", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -270,7 +339,7 @@ func (r *publicIpResource) Update(ctx context.Context, req resource.UpdateReques
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *publicIpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from state
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -282,6 +351,20 @@ func (r *publicIpResource) Delete(ctx context.Context, req resource.DeleteReques
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "public_ip_id", publicIpId)
 
+	if model.RetainOnDelete.ValueBool() {
+		// Release the claim, so that the public IP can be adopted again
+		_, err := r.client.UpdatePublicIP(ctx, projectId, publicIpId).UpdatePublicIPPayload(iaas.UpdatePublicIPPayload{
+			Labels:           &map[string]interface{}{claimLabel: nil},
+			NetworkInterface: iaas.NewNullableString(nil),
+		}).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting public IP", fmt.Sprintf("Disassociating retained public IP: %v", err))
+			return
+		}
+		tflog.Info(ctx, "public IP retained")
+		return
+	}
+
 	// Delete existing publicIp
 	err := r.client.DeletePublicIP(ctx, projectId, publicIpId).Execute()
 	if err != nil {
@@ -312,9 +395,130 @@ func (r *publicIpResource) ImportState(ctx context.Context, req resource.ImportS
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_ip_id"), publicIpId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("retain_on_delete"), false)...)
 	tflog.Info(ctx, "public IP state imported")
 }
 
+// adoptPublicIp looks for an unassociated and unclaimed public IP with all labels of the model, claims it
+// and takes it over by applying the planned configuration. It returns nil if there is no such public IP.
+func (r *publicIpResource) adoptPublicIp(ctx context.Context, model *Model) (*iaas.PublicIp, error) {
+	labels, err := conversion.ToStringInterfaceMap(ctx, model.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("converting labels: %w", err)
+	}
+	projectId := model.ProjectId.ValueString()
+
+	publicIps, err := r.client.ListPublicIPs(ctx, projectId).LabelSelector(toLabelSelector(labels)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("listing public IPs: %w", err)
+	}
+
+	for _, candidate := range adoptablePublicIps(publicIps.GetItems(), labels) {
+		ctx := tflog.SetField(ctx, "public_ip_id", *candidate.Id)
+
+		// The adopted public IP's labels are the current ones, so that additional labels are removed
+		currentLabels, err := iaasUtils.MapLabels(ctx, withoutClaimLabel(candidate.Labels), types.MapNull(types.StringType))
+		if err != nil {
+			return nil, fmt.Errorf("mapping labels of public IP %q: %w", *candidate.Id, err)
+		}
+		payload, err := toUpdatePayload(ctx, model, currentLabels)
+		if err != nil {
+			return nil, fmt.Errorf("creating API payload: %w", err)
+		}
+		claim := uuid.NewString()
+		(*payload.Labels)[claimLabel] = claim
+		_, err = r.client.UpdatePublicIP(ctx, projectId, *candidate.Id).UpdatePublicIPPayload(*payload).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("updating public IP %q: %w", *candidate.Id, err)
+		}
+
+		// Another resource may have claimed the same public IP concurrently, the last claim wins
+		publicIp, err := r.client.GetPublicIP(ctx, projectId, *candidate.Id).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("reading public IP %q: %w", *candidate.Id, err)
+		}
+		if publicIp.GetLabels()[claimLabel] != claim {
+			tflog.Info(ctx, "public IP was claimed by another resource, trying the next one")
+			continue
+		}
+		tflog.Info(ctx, "existing public IP adopted")
+		return publicIp, nil
+	}
+
+	tflog.Info(ctx, "no public IP to adopt found, allocating a new one")
+	return nil, nil
+}
+
+// toLabelSelector builds a label selector matching all of the given labels
+func toLabelSelector(labels map[string]interface{}) string {
+	selector := make([]string, 0, len(labels))
+	for key, value := range labels {
+		selector = append(selector, fmt.Sprintf("%s=%v", key, value))
+	}
+	slices.Sort(selector)
+	return strings.Join(selector, ",")
+}
+
+// adoptablePublicIps returns the unassociated and unclaimed public IPs with all of the given labels,
+// ordered by their address to be deterministic
+func adoptablePublicIps(publicIps []iaas.PublicIp, labels map[string]interface{}) []iaas.PublicIp {
+	adoptable := []iaas.PublicIp{}
+	for i := range publicIps {
+		publicIp := publicIps[i]
+		if publicIp.Id == nil || publicIp.GetNetworkInterface() != nil {
+			continue
+		}
+		if _, ok := publicIp.GetLabels()[claimLabel]; ok {
+			continue
+		}
+		if !hasLabels(publicIp.Labels, labels) {
+			continue
+		}
+		adoptable = append(adoptable, publicIp)
+	}
+	slices.SortFunc(adoptable, func(a, b iaas.PublicIp) int {
+		return compareIps(a.GetIp(), b.GetIp())
+	})
+	return adoptable
+}
+
+// withoutClaimLabel returns the labels without the claim label, which is managed by the provider
+func withoutClaimLabel(labels *map[string]interface{}) *map[string]interface{} {
+	if labels == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(*labels))
+	for key, value := range *labels {
+		if key != claimLabel {
+			result[key] = value
+		}
+	}
+	return &result
+}
+
+func hasLabels(actual *map[string]interface{}, expected map[string]interface{}) bool {
+	for key, value := range expected {
+		if actual == nil {
+			return false
+		}
+		actualValue, ok := (*actual)[key]
+		if !ok || fmt.Sprint(actualValue) != fmt.Sprint(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareIps(a, b string) int {
+	ipA, errA := netip.ParseAddr(a)
+	ipB, errB := netip.ParseAddr(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return ipA.Compare(ipB)
+}
+
 func mapFields(ctx context.Context, publicIpResp *iaas.PublicIp, model *Model) error {
 	if publicIpResp == nil {
 		return fmt.Errorf("response input is nil")
@@ -334,7 +538,7 @@ func mapFields(ctx context.Context, publicIpResp *iaas.PublicIp, model *Model) e
 
 	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), publicIpId)
 
-	labels, err := iaasUtils.MapLabels(ctx, publicIpResp.Labels, model.Labels)
+	labels, err := iaasUtils.MapLabels(ctx, withoutClaimLabel(publicIpResp.Labels), model.Labels)
 	if err != nil {
 		return err
 	}
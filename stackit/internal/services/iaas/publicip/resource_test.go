@@ -2,11 +2,16 @@ package publicip
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 )
@@ -262,3 +267,237 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestToLabelSelector(t *testing.T) {
+	tests := []struct {
+		description string
+		input       map[string]interface{}
+		expected    string
+	}{
+		{"empty", map[string]interface{}{}, ""},
+		{"single", map[string]interface{}{"key": "value"}, "key=value"},
+		{"sorted", map[string]interface{}{"role": "egress", "env": "prod"}, "env=prod,role=egress"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toLabelSelector(tt.input)
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestAdoptablePublicIps(t *testing.T) {
+	labels := map[string]interface{}{"role": "egress"}
+	tests := []struct {
+		description string
+		input       []iaas.PublicIp
+		expectedIds []string
+	}{
+		{
+			"no_public_ips",
+			[]iaas.PublicIp{},
+			[]string{},
+		},
+		{
+			"associated_skipped",
+			[]iaas.PublicIp{
+				{
+					Id:               utils.Ptr("pipid"),
+					Ip:               utils.Ptr("10.0.0.1"),
+					Labels:           &map[string]interface{}{"role": "egress"},
+					NetworkInterface: iaas.NewNullableString(utils.Ptr("interface")),
+				},
+			},
+			[]string{},
+		},
+		{
+			"claimed_skipped",
+			[]iaas.PublicIp{
+				{
+					Id:     utils.Ptr("pipid"),
+					Ip:     utils.Ptr("10.0.0.1"),
+					Labels: &map[string]interface{}{"role": "egress", claimLabel: "claim"},
+				},
+			},
+			[]string{},
+		},
+		{
+			"missing_labels_skipped",
+			[]iaas.PublicIp{
+				{
+					Id:     utils.Ptr("pipid-1"),
+					Ip:     utils.Ptr("10.0.0.1"),
+					Labels: &map[string]interface{}{"role": "ingress"},
+				},
+				{
+					Id: utils.Ptr("pipid-2"),
+					Ip: utils.Ptr("10.0.0.2"),
+				},
+			},
+			[]string{},
+		},
+		{
+			"ordered_by_ip",
+			[]iaas.PublicIp{
+				{
+					Id:               utils.Ptr("pipid-1"),
+					Ip:               utils.Ptr("10.0.0.10"),
+					Labels:           &map[string]interface{}{"role": "egress"},
+					NetworkInterface: iaas.NewNullableString(nil),
+				},
+				{
+					Id:     utils.Ptr("pipid-2"),
+					Ip:     utils.Ptr("10.0.0.9"),
+					Labels: &map[string]interface{}{"role": "egress", "env": "prod"},
+				},
+				{
+					Id:               utils.Ptr("pipid-3"),
+					Ip:               utils.Ptr("10.0.0.1"),
+					Labels:           &map[string]interface{}{"role": "egress"},
+					NetworkInterface: iaas.NewNullableString(utils.Ptr("interface")),
+				},
+			},
+			[]string{"pipid-2", "pipid-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			outputIds := []string{}
+			for _, publicIp := range adoptablePublicIps(tt.input, labels) {
+				outputIds = append(outputIds, *publicIp.Id)
+			}
+			diff := cmp.Diff(outputIds, tt.expectedIds)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAdoptPublicIp(t *testing.T) {
+	projectId := "00000000-0000-0000-0000-000000000000"
+	publicIpId1 := "00000000-0000-0000-0000-000000000001"
+	publicIpId2 := "00000000-0000-0000-0000-000000000002"
+	tests := []struct {
+		description string
+		publicIps   []iaas.PublicIp
+		// claimedConcurrently is the public IP which is claimed by another resource right after it was claimed by the first adoption
+		claimedConcurrently string
+		expectedIds         []*string
+	}{
+		{
+			"two_resources_same_labels",
+			[]iaas.PublicIp{
+				{Id: utils.Ptr(publicIpId1), Ip: utils.Ptr("10.0.0.1"), Labels: &map[string]interface{}{"role": "egress"}},
+				{Id: utils.Ptr(publicIpId2), Ip: utils.Ptr("10.0.0.2"), Labels: &map[string]interface{}{"role": "egress"}},
+			},
+			"",
+			[]*string{utils.Ptr(publicIpId1), utils.Ptr(publicIpId2)},
+		},
+		{
+			"two_resources_same_labels_single_public_ip",
+			[]iaas.PublicIp{
+				{Id: utils.Ptr(publicIpId1), Ip: utils.Ptr("10.0.0.1"), Labels: &map[string]interface{}{"role": "egress"}},
+			},
+			"",
+			[]*string{utils.Ptr(publicIpId1), nil},
+		},
+		{
+			"claim_lost_to_concurrent_resource",
+			[]iaas.PublicIp{
+				{Id: utils.Ptr(publicIpId1), Ip: utils.Ptr("10.0.0.1"), Labels: &map[string]interface{}{"role": "egress"}},
+				{Id: utils.Ptr(publicIpId2), Ip: utils.Ptr("10.0.0.2"), Labels: &map[string]interface{}{"role": "egress"}},
+			},
+			publicIpId1,
+			[]*string{utils.Ptr(publicIpId2), nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			publicIps := map[string]*iaas.PublicIp{}
+			for i := range tt.publicIps {
+				publicIps[*tt.publicIps[i].Id] = &tt.publicIps[i]
+			}
+			writeJSON := func(w http.ResponseWriter, body interface{}) {
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(body); err != nil {
+					t.Errorf("Failed to write response: %v", err)
+				}
+			}
+
+			router := mux.NewRouter()
+			router.HandleFunc("/v1/projects/{projectId}/public-ips", func(w http.ResponseWriter, _ *http.Request) {
+				items := []iaas.PublicIp{}
+				for _, publicIp := range publicIps {
+					items = append(items, *publicIp)
+				}
+				writeJSON(w, iaas.PublicIpListResponse{Items: &items})
+			})
+			router.HandleFunc("/v1/projects/{projectId}/public-ips/{publicIpId}", func(w http.ResponseWriter, r *http.Request) {
+				publicIpId := mux.Vars(r)["publicIpId"]
+				publicIp, ok := publicIps[publicIpId]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if r.Method == http.MethodPatch {
+					var payload struct {
+						Labels map[string]interface{} `json:"labels"`
+					}
+					if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+						t.Errorf("Failed to decode request: %v", err)
+					}
+					labels := map[string]interface{}{}
+					for key, value := range publicIp.GetLabels() {
+						labels[key] = value
+					}
+					for key, value := range payload.Labels {
+						if value == nil {
+							delete(labels, key)
+						} else {
+							labels[key] = value
+						}
+					}
+					if publicIpId == tt.claimedConcurrently {
+						labels[claimLabel] = "other"
+					}
+					publicIp.Labels = &labels
+				}
+				writeJSON(w, publicIp)
+			})
+			mockedServer := httptest.NewServer(router)
+			defer mockedServer.Close()
+			client, err := iaas.NewAPIClient(
+				config.WithEndpoint(mockedServer.URL),
+				config.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			r := &publicIpResource{client: client}
+
+			for _, expectedId := range tt.expectedIds {
+				model := &Model{
+					ProjectId: types.StringValue(projectId),
+					Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+						"role": types.StringValue("egress"),
+					}),
+				}
+				publicIp, err := r.adoptPublicIp(context.Background(), model)
+				if err != nil {
+					t.Fatalf("Should not have failed: %v", err)
+				}
+				var publicIpId *string
+				if publicIp != nil {
+					publicIpId = publicIp.Id
+				}
+				diff := cmp.Diff(publicIpId, expectedId)
+				if diff != "" {
+					t.Fatalf("Adopted public IP does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
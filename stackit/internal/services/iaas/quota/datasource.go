@@ -0,0 +1,251 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &quotasDataSource{}
+)
+
+type DatasourceModel struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	ProjectId          types.String `tfsdk:"project_id"`
+	Vcpu               types.Object `tfsdk:"vcpu"`
+	Ram                types.Object `tfsdk:"ram"`
+	Volumes            types.Object `tfsdk:"volumes"`
+	Gigabytes          types.Object `tfsdk:"gigabytes"`
+	Snapshots          types.Object `tfsdk:"snapshots"`
+	Backups            types.Object `tfsdk:"backups"`
+	BackupGigabytes    types.Object `tfsdk:"backup_gigabytes"`
+	PublicIps          types.Object `tfsdk:"public_ips"`
+	Networks           types.Object `tfsdk:"networks"`
+	Nics               types.Object `tfsdk:"nics"`
+	SecurityGroups     types.Object `tfsdk:"security_groups"`
+	SecurityGroupRules types.Object `tfsdk:"security_group_rules"`
+}
+
+// quotaNames are the attribute names of the quotas of DatasourceModel
+var quotaNames = []string{
+	"vcpu",
+	"ram",
+	"volumes",
+	"gigabytes",
+	"snapshots",
+	"backups",
+	"backup_gigabytes",
+	"public_ips",
+	"networks",
+	"nics",
+	"security_groups",
+	"security_group_rules",
+}
+
+// quotaTypes are the types of each quota of DatasourceModel
+var quotaTypes = map[string]attr.Type{
+	"limit": types.Int64Type,
+	"usage": types.Int64Type,
+}
+
+// NewQuotasDataSource is a helper function to simplify the provider implementation.
+func NewQuotasDataSource() datasource.DataSource {
+	return &quotasDataSource{}
+}
+
+// quotasDataSource is the data source implementation.
+type quotasDataSource struct {
+	client *iaas.APIClient
+}
+
+func (d *quotasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Metadata returns the data source type name.
+func (d *quotasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iaas_quotas"
+}
+
+// Schema defines the schema for the datasource.
+func (d *quotasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "IaaS quotas of a project, with their limit and current usage. Must have a `region` specified in the provider configuration. " +
+			"Can be used in preconditions to fail before resources are created that would exceed a quota.",
+		"id":                   "Terraform's internal data source ID. It is structured as \"`project_id`\".",
+		"project_id":           "STACKIT project ID.",
+		"vcpu":                 "Number of vCPUs of the servers.",
+		"ram":                  "RAM of the servers in MB.",
+		"volumes":              "Number of volumes.",
+		"gigabytes":            "Total size of the volumes in GB.",
+		"snapshots":            "Number of volume snapshots.",
+		"backups":              "Number of volume backups.",
+		"backup_gigabytes":     "Total size of the volume backups in GB.",
+		"public_ips":           "Number of public IPs.",
+		"networks":             "Number of networks.",
+		"nics":                 "Number of network interfaces.",
+		"security_groups":      "Number of security groups.",
+		"security_group_rules": "Number of security group rules.",
+	}
+
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: descriptions["id"],
+			Computed:    true,
+		},
+		"project_id": schema.StringAttribute{
+			Description: descriptions["project_id"],
+			Required:    true,
+			Validators: []validator.String{
+				validate.UUID(),
+				validate.NoSeparator(),
+			},
+		},
+	}
+	for _, name := range quotaNames {
+		attributes[name] = quotaAttribute(descriptions[name])
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: descriptions["main"],
+		Description:         descriptions["main"],
+		Attributes:          attributes,
+	}
+}
+
+// quotaAttribute returns the schema of a single quota
+func quotaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Maximum allowed value.",
+				Computed:    true,
+			},
+			"usage": schema.Int64Attribute{
+				Description: "Current usage.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *quotasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DatasourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	quotasResp, err := d.client.ListQuotasExecute(ctx, projectId)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading quotas",
+			fmt.Sprintf("Unable to read the quotas of project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response body to schema
+	err = mapDataSourceFields(quotasResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading quotas", fmt.Sprintf("Process API payload: %v", err))
+		return
+	}
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "quotas read")
+}
+
+func mapDataSourceFields(quotasResp *iaas.QuotaListResponse, model *DatasourceModel) error {
+	if quotasResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	quotas := quotasResp.GetQuotas()
+	vcpu := quotas.GetVcpu()
+	ram := quotas.GetRam()
+	volumes := quotas.GetVolumes()
+	gigabytes := quotas.GetGigabytes()
+	snapshots := quotas.GetSnapshots()
+	backups := quotas.GetBackups()
+	backupGigabytes := quotas.GetBackupGigabytes()
+	publicIps := quotas.GetPublicIps()
+	networks := quotas.GetNetworks()
+	nics := quotas.GetNics()
+	securityGroups := quotas.GetSecurityGroups()
+	securityGroupRules := quotas.GetSecurityGroupRules()
+
+	for _, quota := range []struct {
+		target       *types.Object
+		limit, usage *int64
+	}{
+		{&model.Vcpu, vcpu.Limit, vcpu.Usage},
+		{&model.Ram, ram.Limit, ram.Usage},
+		{&model.Volumes, volumes.Limit, volumes.Usage},
+		{&model.Gigabytes, gigabytes.Limit, gigabytes.Usage},
+		{&model.Snapshots, snapshots.Limit, snapshots.Usage},
+		{&model.Backups, backups.Limit, backups.Usage},
+		{&model.BackupGigabytes, backupGigabytes.Limit, backupGigabytes.Usage},
+		{&model.PublicIps, publicIps.Limit, publicIps.Usage},
+		{&model.Networks, networks.Limit, networks.Usage},
+		{&model.Nics, nics.Limit, nics.Usage},
+		{&model.SecurityGroups, securityGroups.Limit, securityGroups.Usage},
+		{&model.SecurityGroupRules, securityGroupRules.Limit, securityGroupRules.Usage},
+	} {
+		quotaTF, diags := types.ObjectValue(quotaTypes, map[string]attr.Value{
+			"limit": types.Int64PointerValue(quota.limit),
+			"usage": types.Int64PointerValue(quota.usage),
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping quota: %w", core.DiagsToError(diags))
+		}
+		*quota.target = quotaTF
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+	return nil
+}
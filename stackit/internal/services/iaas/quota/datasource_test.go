@@ -0,0 +1,111 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func quotaValue(limit, usage *int64) types.Object {
+	return types.ObjectValueMust(quotaTypes, map[string]attr.Value{
+		"limit": types.Int64PointerValue(limit),
+		"usage": types.Int64PointerValue(usage),
+	})
+}
+
+func TestMapDataSourceFields(t *testing.T) {
+	const projectId = "pid"
+	tests := []struct {
+		description string
+		input       *iaas.QuotaListResponse
+		expected    *DatasourceModel
+		isValid     bool
+	}{
+		{
+			description: "default_values",
+			input:       &iaas.QuotaListResponse{},
+			expected: &DatasourceModel{
+				Id:                 types.StringValue(projectId),
+				ProjectId:          types.StringValue(projectId),
+				Vcpu:               quotaValue(nil, nil),
+				Ram:                quotaValue(nil, nil),
+				Volumes:            quotaValue(nil, nil),
+				Gigabytes:          quotaValue(nil, nil),
+				Snapshots:          quotaValue(nil, nil),
+				Backups:            quotaValue(nil, nil),
+				BackupGigabytes:    quotaValue(nil, nil),
+				PublicIps:          quotaValue(nil, nil),
+				Networks:           quotaValue(nil, nil),
+				Nics:               quotaValue(nil, nil),
+				SecurityGroups:     quotaValue(nil, nil),
+				SecurityGroupRules: quotaValue(nil, nil),
+			},
+			isValid: true,
+		},
+		{
+			description: "simple_values",
+			input: &iaas.QuotaListResponse{
+				Quotas: &iaas.QuotaList{
+					Vcpu:               &iaas.QuotaListVcpu{Limit: utils.Ptr(int64(1)), Usage: utils.Ptr(int64(2))},
+					Ram:                &iaas.QuotaListRam{Limit: utils.Ptr(int64(3)), Usage: utils.Ptr(int64(4))},
+					Volumes:            &iaas.QuotaListVolumes{Limit: utils.Ptr(int64(5)), Usage: utils.Ptr(int64(6))},
+					Gigabytes:          &iaas.QuotaListGigabytes{Limit: utils.Ptr(int64(7)), Usage: utils.Ptr(int64(8))},
+					Snapshots:          &iaas.QuotaListSnapshots{Limit: utils.Ptr(int64(9)), Usage: utils.Ptr(int64(10))},
+					Backups:            &iaas.QuotaListBackups{Limit: utils.Ptr(int64(11)), Usage: utils.Ptr(int64(12))},
+					BackupGigabytes:    &iaas.QuotaListBackupGigabytes{Limit: utils.Ptr(int64(13)), Usage: utils.Ptr(int64(14))},
+					PublicIps:          &iaas.QuotaListPublicIps{Limit: utils.Ptr(int64(15)), Usage: utils.Ptr(int64(16))},
+					Networks:           &iaas.QuotaListNetworks{Limit: utils.Ptr(int64(17)), Usage: utils.Ptr(int64(18))},
+					Nics:               &iaas.QuotaListNics{Limit: utils.Ptr(int64(19)), Usage: utils.Ptr(int64(20))},
+					SecurityGroups:     &iaas.QuotaListSecurityGroups{Limit: utils.Ptr(int64(21)), Usage: utils.Ptr(int64(22))},
+					SecurityGroupRules: &iaas.QuotaListSecurityGroupRules{Limit: utils.Ptr(int64(23)), Usage: utils.Ptr(int64(24))},
+				},
+			},
+			expected: &DatasourceModel{
+				Id:                 types.StringValue(projectId),
+				ProjectId:          types.StringValue(projectId),
+				Vcpu:               quotaValue(utils.Ptr(int64(1)), utils.Ptr(int64(2))),
+				Ram:                quotaValue(utils.Ptr(int64(3)), utils.Ptr(int64(4))),
+				Volumes:            quotaValue(utils.Ptr(int64(5)), utils.Ptr(int64(6))),
+				Gigabytes:          quotaValue(utils.Ptr(int64(7)), utils.Ptr(int64(8))),
+				Snapshots:          quotaValue(utils.Ptr(int64(9)), utils.Ptr(int64(10))),
+				Backups:            quotaValue(utils.Ptr(int64(11)), utils.Ptr(int64(12))),
+				BackupGigabytes:    quotaValue(utils.Ptr(int64(13)), utils.Ptr(int64(14))),
+				PublicIps:          quotaValue(utils.Ptr(int64(15)), utils.Ptr(int64(16))),
+				Networks:           quotaValue(utils.Ptr(int64(17)), utils.Ptr(int64(18))),
+				Nics:               quotaValue(utils.Ptr(int64(19)), utils.Ptr(int64(20))),
+				SecurityGroups:     quotaValue(utils.Ptr(int64(21)), utils.Ptr(int64(22))),
+				SecurityGroupRules: quotaValue(utils.Ptr(int64(23)), utils.Ptr(int64(24))),
+			},
+			isValid: true,
+		},
+		{
+			description: "response_nil_fail",
+			input:       nil,
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &DatasourceModel{
+				ProjectId: types.StringValue(projectId),
+			}
+			err := mapDataSourceFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
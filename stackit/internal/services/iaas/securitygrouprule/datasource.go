@@ -54,7 +54,6 @@ func (d *securityGroupRuleDataSource) Configure(ctx context.Context, req datasou
 
 // Schema defines the schema for the resource.
 func (r *securityGroupRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	directionOptions := []string{"ingress", "egress"}
 	description := "Security group datasource schema. Must have a `region` specified in the provider configuration."
 
 	resp.Schema = schema.Schema{
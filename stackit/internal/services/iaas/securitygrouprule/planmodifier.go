@@ -2,8 +2,10 @@ package securitygrouprule
 
 import (
 	"context"
-	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -52,7 +54,7 @@ func (m useNullForUnknownBasedOnProtocolModifier) PlanModifyObject(ctx context.C
 	}
 
 	// If there is an unknown configuration value, check if the value of protocol.name attribute corresponds to an icmp protocol. If it does, set the attribute value to null
-	var model Model
+	var model ResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -71,12 +73,13 @@ func (m useNullForUnknownBasedOnProtocolModifier) PlanModifyObject(ctx context.C
 	}
 
 	protocolName := conversion.StringValueToPointer(protocol.Name)
+	protocolNumber := conversion.Int64ValueToPointer(protocol.Number)
 
-	if protocolName == nil {
+	if protocolName == nil && protocolNumber == nil {
 		return
 	}
 
-	if slices.Contains(icmpProtocols, *protocolName) {
+	if isIcmpProtocol(protocolName, protocolNumber) {
 		if model.PortRange.IsUnknown() {
 			resp.PlanValue = types.ObjectNull(portRangeTypes)
 			return
@@ -91,3 +94,222 @@ func (m useNullForUnknownBasedOnProtocolModifier) PlanModifyObject(ctx context.C
 	// use state for unknown if the value was not set to null
 	resp.PlanValue = req.StateValue
 }
+
+// UsePortsForPortRangeModifier returns a plan modifier that plans the port_range
+// attribute from the `ports` shorthand, if it is configured.
+func UsePortsForPortRangeModifier() planmodifier.Object {
+	return usePortsForPortRangeModifier{}
+}
+
+// usePortsForPortRangeModifier implements the plan modifier.
+type usePortsForPortRangeModifier struct{}
+
+func (m usePortsForPortRangeModifier) Description(_ context.Context) string {
+	return "If the ports attribute is set, the value of this attribute is derived from it."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m usePortsForPortRangeModifier) MarkdownDescription(_ context.Context) string {
+	return "If the `ports` attribute is set, the value of this attribute is derived from it."
+}
+
+// PlanModifyObject implements the plan modification logic.
+func (m usePortsForPortRangeModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) { // nolint:gocritic // function signature required by Terraform
+	var ports types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ports"), &ports)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ports.IsNull() || ports.IsUnknown() {
+		return
+	}
+
+	minPort, maxPort, err := parsePorts(ports.ValueString())
+	if err != nil {
+		// Reported by the config validation
+		return
+	}
+	portRange, diags := types.ObjectValue(portRangeTypes, map[string]attr.Value{
+		"max": types.Int64Value(maxPort),
+		"min": types.Int64Value(minPort),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.PlanValue = portRange
+}
+
+// UseProtocolNameForNumberModifier returns a plan modifier that plans the canonical
+// protocol name, if only protocol.number is configured.
+//
+// Without it the name would stay "(known after apply)" or keep the value of the previous
+// protocol from the state, which doesn't match the name the API reports after a change.
+func UseProtocolNameForNumberModifier() planmodifier.String {
+	return useProtocolNameForNumberModifier{}
+}
+
+// useProtocolNameForNumberModifier implements the plan modifier.
+type useProtocolNameForNumberModifier struct{}
+
+func (m useProtocolNameForNumberModifier) Description(_ context.Context) string {
+	return "If only protocol.number is set, the value of this attribute is the corresponding protocol name."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useProtocolNameForNumberModifier) MarkdownDescription(_ context.Context) string {
+	return "If only `protocol.number` is set, the value of this attribute is the corresponding protocol name."
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m useProtocolNameForNumberModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) { // nolint:gocritic // function signature required by Terraform
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var number types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("number"), &number)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if number.IsNull() || number.IsUnknown() {
+		return
+	}
+
+	var stateNumber types.Int64
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, req.Path.ParentPath().AtName("number"), &stateNumber)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	// Keep whatever the API reported for an unchanged protocol
+	if stateNumber.Equal(number) && !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+
+	if name, ok := protocolNameByNumber(number.ValueInt64()); ok {
+		resp.PlanValue = types.StringValue(name)
+		return
+	}
+	resp.PlanValue = types.StringUnknown()
+}
+
+// UseIpRangeForEtherTypeModifier returns a plan modifier that plans the ethertype
+// matching the address family of ip_range, if ether_type is not configured.
+//
+// Without it an IPv6 ip_range would be planned with the IPv4 ethertype from the state
+// or the API default.
+func UseIpRangeForEtherTypeModifier() planmodifier.String {
+	return useIpRangeForEtherTypeModifier{}
+}
+
+// useIpRangeForEtherTypeModifier implements the plan modifier.
+type useIpRangeForEtherTypeModifier struct{}
+
+func (m useIpRangeForEtherTypeModifier) Description(_ context.Context) string {
+	return "If ether_type is not set, the value of this attribute is the address family of ip_range."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useIpRangeForEtherTypeModifier) MarkdownDescription(_ context.Context) string {
+	return "If `ether_type` is not set, the value of this attribute is the address family of `ip_range`."
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m useIpRangeForEtherTypeModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) { // nolint:gocritic // function signature required by Terraform
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var ipRange types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ip_range"), &ipRange)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ipRange.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	if ipRange.IsNull() {
+		return
+	}
+
+	if etherType, ok := etherTypeForIpRange(ipRange.ValueString()); ok {
+		resp.PlanValue = types.StringValue(etherType)
+	}
+}
+
+// UseProtocolNumberForNameModifier returns a plan modifier that plans the IANA
+// protocol number, if only protocol.name is configured.
+func UseProtocolNumberForNameModifier() planmodifier.Int64 {
+	return useProtocolNumberForNameModifier{}
+}
+
+// useProtocolNumberForNameModifier implements the plan modifier.
+type useProtocolNumberForNameModifier struct{}
+
+func (m useProtocolNumberForNameModifier) Description(_ context.Context) string {
+	return "If only protocol.name is set, the value of this attribute is the corresponding protocol number."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useProtocolNumberForNameModifier) MarkdownDescription(_ context.Context) string {
+	return "If only `protocol.name` is set, the value of this attribute is the corresponding protocol number."
+}
+
+// PlanModifyInt64 implements the plan modification logic.
+func (m useProtocolNumberForNameModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) { // nolint:gocritic // function signature required by Terraform
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if name.IsNull() || name.IsUnknown() {
+		return
+	}
+
+	if number, ok := protocolNumberByName(name.ValueString()); ok {
+		resp.PlanValue = types.Int64Value(number)
+		return
+	}
+	resp.PlanValue = types.Int64Unknown()
+}
+
+// protocolRequiresReplace requires replacement only if the configured protocol differs from
+// the one in state, regardless of whether it is given by name or number and of the name's casing.
+func protocolRequiresReplace(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) { // nolint:gocritic // function signature required by Terraform
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.StateValue.IsNull() {
+		return
+	}
+
+	configProtocol := &protocolModel{}
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, configProtocol, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	stateProtocol := &protocolModel{}
+	resp.Diagnostics.Append(req.StateValue.As(ctx, stateProtocol, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configProtocol.Name.IsUnknown() || configProtocol.Number.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	resp.RequiresReplace = !sameProtocol(
+		conversion.StringValueToPointer(configProtocol.Name),
+		conversion.Int64ValueToPointer(configProtocol.Number),
+		conversion.StringValueToPointer(stateProtocol.Name),
+		conversion.Int64ValueToPointer(stateProtocol.Number),
+	)
+}
+
+// portRangeRequiresReplace requires replacement if the planned port range differs from the one in state,
+// no matter if it is configured directly or via the ports shorthand.
+func portRangeRequiresReplace(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) { // nolint:gocritic // function signature required by Terraform
+	resp.RequiresReplace = !req.PlanValue.IsUnknown() && !req.PlanValue.Equal(req.StateValue)
+}
@@ -0,0 +1,270 @@
+package securitygrouprule
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// protocolNumbers maps the protocol names accepted by the API to their IANA protocol numbers.
+var protocolNumbers = map[string]int64{
+	"ah":         51,
+	"dccp":       33,
+	"egp":        8,
+	"esp":        50,
+	"gre":        47,
+	"icmp":       1,
+	"igmp":       2,
+	"ipip":       4,
+	"ipv6-encap": 41,
+	"ipv6-frag":  44,
+	"ipv6-icmp":  58,
+	"ipv6-nonxt": 59,
+	"ipv6-opts":  60,
+	"ipv6-route": 43,
+	"ospf":       89,
+	"pgm":        113,
+	"rsvp":       46,
+	"sctp":       132,
+	"tcp":        6,
+	"udp":        17,
+	"udplite":    136,
+	"vrrp":       112,
+}
+
+// servicePorts maps the service shorthands accepted by the `ports` attribute to their well-known port.
+var servicePorts = map[string]int64{
+	"ftp":        21,
+	"ssh":        22,
+	"telnet":     23,
+	"smtp":       25,
+	"dns":        53,
+	"http":       80,
+	"pop3":       110,
+	"ntp":        123,
+	"imap":       143,
+	"snmp":       161,
+	"ldap":       389,
+	"https":      443,
+	"smtps":      465,
+	"submission": 587,
+	"ldaps":      636,
+	"imaps":      993,
+	"pop3s":      995,
+	"mssql":      1433,
+	"mysql":      3306,
+	"rdp":        3389,
+	"postgresql": 5432,
+	"redis":      6379,
+	"http-alt":   8080,
+}
+
+// icmpCodes lists the valid codes of the well-known ICMP types, per ICMP protocol.
+// Types which are not listed accept any code.
+var icmpCodes = map[string]map[int64][]int64{
+	"icmp": {
+		0:  {0},              // echo reply
+		3:  codeRange(0, 15), // destination unreachable
+		4:  {0},              // source quench
+		5:  codeRange(0, 3),  // redirect
+		8:  {0},              // echo request
+		9:  {0, 16},          // router advertisement
+		10: {0},              // router solicitation
+		11: codeRange(0, 1),  // time exceeded
+		12: codeRange(0, 2),  // parameter problem
+		13: {0},              // timestamp
+		14: {0},              // timestamp reply
+	},
+	"ipv6-icmp": {
+		1:   codeRange(0, 8),  // destination unreachable
+		2:   {0},              // packet too big
+		3:   codeRange(0, 1),  // time exceeded
+		4:   codeRange(0, 10), // parameter problem
+		128: {0},              // echo request
+		129: {0},              // echo reply
+		133: {0},              // router solicitation
+		134: {0},              // router advertisement
+		135: {0},              // neighbor solicitation
+		136: {0},              // neighbor advertisement
+		137: {0},              // redirect
+	},
+}
+
+func codeRange(lower, upper int64) []int64 {
+	codes := make([]int64, 0, upper-lower+1)
+	for c := lower; c <= upper; c++ {
+		codes = append(codes, c)
+	}
+	return codes
+}
+
+// canonicalProtocolName returns the name in the form the API reports it.
+func canonicalProtocolName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// protocolNumberByName returns the IANA number of the given protocol name.
+func protocolNumberByName(name string) (int64, bool) {
+	number, ok := protocolNumbers[canonicalProtocolName(name)]
+	return number, ok
+}
+
+// protocolNameByNumber returns the canonical protocol name of the given IANA number.
+func protocolNameByNumber(number int64) (string, bool) {
+	for name, n := range protocolNumbers {
+		if n == number {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// resolveProtocolName returns the canonical protocol name for a protocol given by name or number.
+func resolveProtocolName(name *string, number *int64) (string, bool) {
+	if name != nil {
+		return canonicalProtocolName(*name), true
+	}
+	if number != nil {
+		return protocolNameByNumber(*number)
+	}
+	return "", false
+}
+
+// sameProtocol reports whether two protocols, each given by name and/or number, are the same.
+func sameProtocol(nameA *string, numberA *int64, nameB *string, numberB *int64) bool {
+	resolvedA, okA := resolveProtocolNumber(nameA, numberA)
+	resolvedB, okB := resolveProtocolNumber(nameB, numberB)
+	if okA && okB {
+		return resolvedA == resolvedB
+	}
+	// Unknown names can only be compared literally
+	return nameA != nil && nameB != nil && canonicalProtocolName(*nameA) == canonicalProtocolName(*nameB)
+}
+
+func resolveProtocolNumber(name *string, number *int64) (int64, bool) {
+	if number != nil {
+		return *number, true
+	}
+	if name != nil {
+		return protocolNumberByName(*name)
+	}
+	return 0, false
+}
+
+// isIcmpProtocol reports whether the protocol given by name or number is icmp or ipv6-icmp.
+func isIcmpProtocol(name *string, number *int64) bool {
+	resolved, ok := resolveProtocolName(name, number)
+	return ok && slices.Contains(icmpProtocols, resolved)
+}
+
+// validateIcmpParameters checks that the code is valid for the ICMP type of the given ICMP protocol.
+func validateIcmpParameters(protocolName string, icmpType, code int64) error {
+	codes, ok := icmpCodes[canonicalProtocolName(protocolName)][icmpType]
+	if !ok || slices.Contains(codes, code) {
+		return nil
+	}
+	validCodes := make([]string, 0, len(codes))
+	for _, c := range codes {
+		validCodes = append(validCodes, strconv.FormatInt(c, 10))
+	}
+	return fmt.Errorf("code %d is not valid for %s type %d, valid codes are: %s", code, canonicalProtocolName(protocolName), icmpType, strings.Join(validCodes, ", "))
+}
+
+// etherTypeForIpRange returns the ethertype matching the address family of the IP range.
+// It returns false if the IP range can't be parsed.
+func etherTypeForIpRange(ipRange string) (string, bool) {
+	var addr netip.Addr
+	if prefix, err := netip.ParsePrefix(ipRange); err == nil {
+		addr = prefix.Addr()
+	} else if addr, err = netip.ParseAddr(ipRange); err != nil {
+		return "", false
+	}
+	if addr.Is4() || addr.Is4In6() {
+		return etherTypeIPv4, true
+	}
+	return etherTypeIPv6, true
+}
+
+// effectiveEtherType returns the configured ethertype or, if it isn't set, the ethertype matching the
+// address family of the IP range. It returns false if neither is set.
+func effectiveEtherType(etherType, ipRange *string) (string, bool) {
+	if etherType != nil {
+		return *etherType, true
+	}
+	if ipRange != nil {
+		return etherTypeForIpRange(*ipRange)
+	}
+	return "", false
+}
+
+// validateEtherType checks that the ethertype matches the address family of the IP range and of the ICMP protocol.
+// Unset values are not validated.
+func validateEtherType(etherType, ipRange, protocolName *string, protocolNumber *int64) error {
+	if etherType != nil && ipRange != nil {
+		if ipRangeEtherType, ok := etherTypeForIpRange(*ipRange); ok && ipRangeEtherType != *etherType {
+			return fmt.Errorf("ethertype %s doesn't match the IP range %s, which requires ethertype %s", *etherType, *ipRange, ipRangeEtherType)
+		}
+	}
+	resolvedEtherType, ok := effectiveEtherType(etherType, ipRange)
+	if !ok {
+		return nil
+	}
+	protocol, ok := resolveProtocolName(protocolName, protocolNumber)
+	if !ok {
+		return nil
+	}
+	if protocol == "icmp" && resolvedEtherType == etherTypeIPv6 {
+		return fmt.Errorf("protocol icmp can't be used with ethertype %s, use ipv6-icmp instead", etherTypeIPv6)
+	}
+	if protocol == "ipv6-icmp" && resolvedEtherType == etherTypeIPv4 {
+		return fmt.Errorf("protocol ipv6-icmp can't be used with ethertype %s, use icmp instead", etherTypeIPv4)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// parsePorts parses a port shorthand: a single port ("22"), a range ("22-23") or a service name ("https").
+func parsePorts(value string) (minPort, maxPort int64, err error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if port, ok := servicePorts[value]; ok {
+		return port, port, nil
+	}
+
+	lower, upper, isRange := strings.Cut(value, "-")
+	minPort, err = parsePort(lower)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return minPort, minPort, nil
+	}
+	maxPort, err = parsePort(upper)
+	if err != nil {
+		return 0, 0, err
+	}
+	if minPort > maxPort {
+		return 0, 0, fmt.Errorf("minimum port %d is greater than maximum port %d", minPort, maxPort)
+	}
+	return minPort, maxPort, nil
+}
+
+func parsePort(value string) (int64, error) {
+	port, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is neither a port, a port range nor a known service", value)
+	}
+	if port < 0 || port > 65535 {
+		return 0, fmt.Errorf("port %d is out of range 0-65535", port)
+	}
+	return port, nil
+}
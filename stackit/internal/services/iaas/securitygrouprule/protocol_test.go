@@ -0,0 +1,178 @@
+package securitygrouprule
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+)
+
+func TestParsePorts(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expectedMin int64
+		expectedMax int64
+		isValid     bool
+	}{
+		{"single_port", "22", 22, 22, true},
+		{"range", "22-23", 22, 23, true},
+		{"range_with_spaces", " 8000 - 8080 ", 8000, 8080, true},
+		{"service", "https", 443, 443, true},
+		{"service_uppercase", "SSH", 22, 22, true},
+		{"full_range", "0-65535", 0, 65535, true},
+		{"reversed_range", "23-22", 0, 0, false},
+		{"out_of_range", "65536", 0, 0, false},
+		{"unknown_service", "gopher", 0, 0, false},
+		{"open_range", "22-", 0, 0, false},
+		{"empty", "", 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			minPort, maxPort, err := parsePorts(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if minPort != tt.expectedMin || maxPort != tt.expectedMax {
+				t.Fatalf("Expected %d-%d, got %d-%d", tt.expectedMin, tt.expectedMax, minPort, maxPort)
+			}
+		})
+	}
+}
+
+func TestValidateIcmpParameters(t *testing.T) {
+	tests := []struct {
+		description string
+		protocol    string
+		icmpType    int64
+		code        int64
+		isValid     bool
+	}{
+		{"echo_request", "icmp", 8, 0, true},
+		{"echo_request_invalid_code", "icmp", 8, 1, false},
+		{"destination_unreachable", "icmp", 3, 13, true},
+		{"destination_unreachable_invalid_code", "icmp", 3, 16, false},
+		{"router_advertisement", "icmp", 9, 16, true},
+		{"unlisted_type", "icmp", 42, 7, true},
+		{"ipv6_echo_request", "ipv6-icmp", 128, 0, true},
+		{"ipv6_echo_request_invalid_code", "ipv6-icmp", 128, 3, false},
+		{"ipv6_type_not_valid_for_icmp", "icmp", 128, 3, true},
+		{"uppercase_protocol", "ICMP", 0, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := validateIcmpParameters(tt.protocol, tt.icmpType, tt.code)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestEtherTypeForIpRange(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    string
+		isValid     bool
+	}{
+		{"ipv4_cidr", "10.0.0.0/24", "IPv4", true},
+		{"ipv4_address", "10.0.0.1", "IPv4", true},
+		{"ipv6_cidr", "2001:db8::/32", "IPv6", true},
+		{"ipv6_any", "::/0", "IPv6", true},
+		{"ipv4_mapped", "::ffff:10.0.0.1", "IPv4", true},
+		{"invalid", "foo", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, ok := etherTypeForIpRange(tt.input)
+			if ok != tt.isValid {
+				t.Fatalf("Expected valid to be %t, got %t", tt.isValid, ok)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestValidateEtherType(t *testing.T) {
+	tests := []struct {
+		description    string
+		etherType      *string
+		ipRange        *string
+		protocolName   *string
+		protocolNumber *int64
+		isValid        bool
+	}{
+		{"nothing_set", nil, nil, nil, nil, true},
+		{"ipv4_range", utils.Ptr("IPv4"), utils.Ptr("10.0.0.0/24"), nil, nil, true},
+		{"ipv6_range", utils.Ptr("IPv6"), utils.Ptr("2001:db8::/32"), nil, nil, true},
+		{"ipv4_with_ipv6_range", utils.Ptr("IPv4"), utils.Ptr("2001:db8::/32"), nil, nil, false},
+		{"ipv6_with_ipv4_range", utils.Ptr("IPv6"), utils.Ptr("0.0.0.0/0"), nil, nil, false},
+		{"icmp_derived_ipv4", nil, utils.Ptr("10.0.0.0/24"), utils.Ptr("icmp"), nil, true},
+		{"icmp_derived_ipv6", nil, utils.Ptr("2001:db8::/32"), utils.Ptr("icmp"), nil, false},
+		{"icmp_number_ipv6", utils.Ptr("IPv6"), nil, nil, utils.Ptr(int64(1)), false},
+		{"ipv6_icmp_ipv6", utils.Ptr("IPv6"), nil, utils.Ptr("ipv6-icmp"), nil, true},
+		{"ipv6_icmp_ipv4", utils.Ptr("IPv4"), nil, utils.Ptr("ipv6-icmp"), nil, false},
+		{"ipv6_icmp_without_ethertype", nil, nil, utils.Ptr("ipv6-icmp"), nil, true},
+		{"tcp_ipv6", nil, utils.Ptr("::/0"), utils.Ptr("tcp"), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := validateEtherType(tt.etherType, tt.ipRange, tt.protocolName, tt.protocolNumber)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestSameProtocol(t *testing.T) {
+	tests := []struct {
+		description string
+		nameA       *string
+		numberA     *int64
+		nameB       *string
+		numberB     *int64
+		expected    bool
+	}{
+		{"same_name", utils.Ptr("tcp"), nil, utils.Ptr("tcp"), nil, true},
+		{"name_casing", utils.Ptr("TCP"), nil, utils.Ptr("tcp"), utils.Ptr(int64(6)), true},
+		{"name_and_number", utils.Ptr("udp"), nil, nil, utils.Ptr(int64(17)), true},
+		{"number_and_name", nil, utils.Ptr(int64(58)), utils.Ptr("ipv6-icmp"), nil, true},
+		{"different", utils.Ptr("tcp"), nil, nil, utils.Ptr(int64(17)), false},
+		{"unmapped_number", nil, utils.Ptr(int64(200)), nil, utils.Ptr(int64(200)), true},
+		{"unmapped_number_and_name", nil, utils.Ptr(int64(200)), utils.Ptr("tcp"), nil, false},
+		{"nothing", nil, nil, nil, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := sameProtocol(tt.nameA, tt.numberA, tt.nameB, tt.numberB)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestProtocolNumbers(t *testing.T) {
+	for _, name := range protocolsPossibleValues {
+		number, ok := protocolNumberByName(name)
+		if !ok {
+			t.Fatalf("No number for protocol %q", name)
+		}
+		resolved, ok := protocolNameByNumber(number)
+		if !ok || resolved != name {
+			t.Fatalf("Protocol number %d resolved to %q, expected %q", number, resolved, name)
+		}
+	}
+}
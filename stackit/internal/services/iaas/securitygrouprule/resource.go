@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
-	"slices"
 	"strings"
 
 	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
@@ -42,6 +41,8 @@ var (
 		"ah", "dccp", "egp", "esp", "gre", "icmp", "igmp", "ipip", "ipv6-encap", "ipv6-frag", "ipv6-icmp",
 		"ipv6-nonxt", "ipv6-opts", "ipv6-route", "ospf", "pgm", "rsvp", "sctp", "tcp", "udp", "udplite", "vrrp",
 	}
+	directionOptions = []string{"ingress", "egress"}
+	ipRangeRegex     = regexp.MustCompile(`^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)(\/(3[0-2]|2[0-9]|1[0-9]|[0-9]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))(\/((1(1[0-9]|2[0-8]))|([0-9][0-9])|([0-9])))?$`)
 )
 
 type Model struct {
@@ -59,6 +60,11 @@ type Model struct {
 	RemoteSecurityGroupId types.String `tfsdk:"remote_security_group_id"`
 }
 
+type ResourceModel struct {
+	Model
+	Ports types.String `tfsdk:"ports"`
+}
+
 type icmpParametersModel struct {
 	Code types.Int64 `tfsdk:"code"`
 	Type types.Int64 `tfsdk:"type"`
@@ -123,7 +129,7 @@ func (r *securityGroupRuleResource) Configure(ctx context.Context, req resource.
 }
 
 func (r securityGroupRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var model Model
+	var model ResourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 
@@ -131,38 +137,73 @@ func (r securityGroupRuleResource) ValidateConfig(ctx context.Context, req resou
 		return
 	}
 
-	// If protocol is not configured, return without error.
-	if model.Protocol.IsNull() || model.Protocol.IsUnknown() {
-		return
+	if !(model.Ports.IsNull() || model.Ports.IsUnknown()) {
+		if _, _, err := parsePorts(model.Ports.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ports"),
+				"Invalid attribute configuration",
+				fmt.Sprintf("`ports` must be a port, a port range like `22-23` or a known service name: %v", err),
+			)
+		}
 	}
 
-	protocol := &protocolModel{}
-	diags := model.Protocol.As(ctx, protocol, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	var protocolName *string
+	var protocolNumber *int64
+	if !(model.Protocol.IsNull() || model.Protocol.IsUnknown()) {
+		protocol := &protocolModel{}
+		resp.Diagnostics.Append(model.Protocol.As(ctx, protocol, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		protocolName = conversion.StringValueToPointer(protocol.Name)
+		protocolNumber = conversion.Int64ValueToPointer(protocol.Number)
 	}
 
-	protocolName := conversion.StringValueToPointer(protocol.Name)
+	if err := validateEtherType(conversion.StringValueToPointer(model.EtherType), conversion.StringValueToPointer(model.IpRange), protocolName, protocolNumber); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ether_type"),
+			"Invalid attribute configuration",
+			err.Error(),
+		)
+	}
 
-	if protocolName == nil {
+	// If protocol is not configured, return without error.
+	if protocolName == nil && protocolNumber == nil {
 		return
 	}
 
-	if slices.Contains(icmpProtocols, *protocolName) {
-		if !(model.PortRange.IsNull() || model.PortRange.IsUnknown()) {
+	if isIcmpProtocol(protocolName, protocolNumber) {
+		if !(model.PortRange.IsNull() || model.PortRange.IsUnknown()) || !(model.Ports.IsNull() || model.Ports.IsUnknown()) {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("port_range"),
 				"Conflicting attribute configuration",
-				"`port_range` attribute can't be provided if `protocol.name` is set to `icmp` or `ipv6-icmp`",
+				"`port_range` and `ports` attributes can't be provided if the protocol is `icmp` or `ipv6-icmp`",
 			)
 		}
+		if !(model.IcmpParameters.IsNull() || model.IcmpParameters.IsUnknown()) {
+			icmpParameters := &icmpParametersModel{}
+			resp.Diagnostics.Append(model.IcmpParameters.As(ctx, icmpParameters, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if icmpParameters.Type.IsUnknown() || icmpParameters.Code.IsUnknown() {
+				return
+			}
+			name, _ := resolveProtocolName(protocolName, protocolNumber)
+			if err := validateIcmpParameters(name, icmpParameters.Type.ValueInt64(), icmpParameters.Code.ValueInt64()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("icmp_parameters"),
+					"Invalid attribute configuration",
+					err.Error(),
+				)
+			}
+		}
 	} else {
 		if !(model.IcmpParameters.IsNull() || model.IcmpParameters.IsUnknown()) {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("icmp_parameters"),
 				"Conflicting attribute configuration",
-				"`icmp_parameters` attribute can't be provided if `protocol.name` is not `icmp` or `ipv6-icmp`",
+				"`icmp_parameters` attribute can't be provided if the protocol is not `icmp` or `ipv6-icmp`",
 			)
 		}
 	}
@@ -170,7 +211,6 @@ func (r securityGroupRuleResource) ValidateConfig(ctx context.Context, req resou
 
 // Schema defines the schema for the resource.
 func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	directionOptions := []string{"ingress", "egress"}
 	description := "Security group rule resource schema. Must have a `region` specified in the provider configuration."
 
 	resp.Schema = schema.Schema{
@@ -223,7 +263,7 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 				Description: "The rule description.",
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(127),
@@ -237,12 +277,16 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"ether_type": schema.StringAttribute{
-				Description: "The ethertype which the rule should match.",
+				Description: fmt.Sprintf("The ethertype which the rule should match. Defaults to the address family of `ip_range`, or to `%s` if neither is set. %s", defaultEtherType, utils.SupportedValuesDocumentation(etherTypeOptions)),
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplaceIfConfigured(),
+					UseIpRangeForEtherTypeModifier(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(etherTypeOptions...),
 				},
 			},
 			"icmp_parameters": schema.SingleNestedAttribute{
@@ -251,7 +295,7 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:    true,
 				PlanModifiers: []planmodifier.Object{
 					UseNullForUnknownBasedOnProtocolModifier(),
-					objectplanmodifier.RequiresReplaceIfConfigured(),
+					objectplanmodifier.RequiresReplace(),
 				},
 				Attributes: map[string]schema.Attribute{
 					"code": schema.Int64Attribute{
@@ -286,17 +330,22 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)(\/(3[0-2]|2[0-9]|1[0-9]|[0-9]))$|^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))(\/((1(1[0-9]|2[0-8]))|([0-9][0-9])|([0-9])))?$`),
+						ipRangeRegex,
 						"must match expression"),
 				},
 			},
 			"port_range": schema.SingleNestedAttribute{
-				Description: "The range of ports. This should only be provided if the protocol is not ICMP.",
+				Description: "The range of ports. This should only be provided if the protocol is not ICMP. Conflicts with `ports`.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplaceIfConfigured(),
+					UsePortsForPortRangeModifier(),
 					UseNullForUnknownBasedOnProtocolModifier(),
+					objectplanmodifier.RequiresReplaceIf(
+						portRangeRequiresReplace,
+						"Changing the effective port range requires replacement.",
+						"Changing the effective port range requires replacement.",
+					),
 				},
 				Attributes: map[string]schema.Attribute{
 					"max": schema.Int64Attribute{
@@ -328,7 +377,11 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplaceIfConfigured(),
+					objectplanmodifier.RequiresReplaceIf(
+						protocolRequiresReplace,
+						"Changing the protocol requires replacement, switching between its name and number does not.",
+						"Changing the protocol requires replacement, switching between its name and number does not.",
+					),
 					objectplanmodifier.UseStateForUnknown(),
 				},
 				Attributes: map[string]schema.Attribute{
@@ -343,10 +396,11 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 							stringvalidator.ConflictsWith(
 								path.MatchRoot("protocol").AtName("number"),
 							),
+							stringvalidator.OneOfCaseInsensitive(protocolsPossibleValues...),
 						},
 						PlanModifiers: []planmodifier.String{
+							UseProtocolNameForNumberModifier(),
 							stringplanmodifier.UseStateForUnknown(),
-							stringplanmodifier.RequiresReplaceIfConfigured(),
 						},
 					},
 					"number": schema.Int64Attribute{
@@ -354,8 +408,8 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 						Optional:    true,
 						Computed:    true,
 						PlanModifiers: []planmodifier.Int64{
+							UseProtocolNumberForNameModifier(),
 							int64planmodifier.UseStateForUnknown(),
-							int64planmodifier.RequiresReplaceIfConfigured(),
 						},
 						Validators: []validator.Int64{
 							int64validator.AtLeast(0),
@@ -364,6 +418,15 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 					},
 				},
 			},
+			"ports": schema.StringAttribute{
+				Description: fmt.Sprintf("Shorthand for `port_range`: a single port like `22`, a range like `22-23` or the name of a well-known service. Conflicts with `port_range`. Known services are: %s.", strings.Join(utils.QuoteValues(sortedKeys(servicePorts)), ", ")),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("port_range"),
+					),
+				},
+			},
 			"remote_security_group_id": schema.StringAttribute{
 				Description: "The remote security group which the rule should match.",
 				Optional:    true,
@@ -382,7 +445,7 @@ func (r *securityGroupRuleResource) Schema(_ context.Context, _ resource.SchemaR
 // Create creates the resource and sets the initial Terraform state.
 func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
-	var model Model
+	var model ResourceModel
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -425,7 +488,7 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Generate API request body from model
-	payload, err := toCreatePayload(&model, icmpParameters, portRange, protocol)
+	payload, err := toCreatePayload(&model.Model, icmpParameters, portRange, protocol)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating security group rule", fmt.Sprintf("Creating API payload: %v", err))
 		return
@@ -441,7 +504,7 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 	ctx = tflog.SetField(ctx, "security_group_rule_id", *securityGroupRule.Id)
 
 	// Map response body to schema
-	err = mapFields(securityGroupRule, &model)
+	err = mapFields(securityGroupRule, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating security group rule", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -457,7 +520,7 @@ func (r *securityGroupRuleResource) Create(ctx context.Context, req resource.Cre
 
 // Read refreshes the Terraform state with the latest data.
 func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -482,7 +545,7 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Map response body to schema
-	err = mapFields(securityGroupRuleResp, &model)
+	err = mapFields(securityGroupRuleResp, &model.Model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group rule", fmt.Sprintf("Processing API payload: %v", err))
 		return
@@ -497,15 +560,28 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, req resource.ReadR
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
-func (r *securityGroupRuleResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating security group rule", "Security group rule can't be updated")
+// All attributes which change the rule require replacement, so an update only switches between
+// equivalent notations (e.g. `ports` and `port_range`, protocol name and number) and just stores the plan.
+func (r *securityGroupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model ResourceModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "security group rule updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *securityGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from state
-	var model Model
+	var model ResourceModel
 	diags := req.State.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -659,6 +735,11 @@ func mapProtocol(securityGroupRuleResp *iaas.SecurityGroupRule, m *Model) error
 	protocolNameValue := types.StringNull()
 	if securityGroupRuleResp.Protocol.Name != nil {
 		protocolNameValue = types.StringValue(*securityGroupRuleResp.Protocol.Name)
+		// The API normalizes protocol names, keep the notation of the configuration to avoid diffs
+		if currentName, ok := m.Protocol.Attributes()["name"].(types.String); ok && !currentName.IsNull() && !currentName.IsUnknown() &&
+			canonicalProtocolName(currentName.ValueString()) == canonicalProtocolName(*securityGroupRuleResp.Protocol.Name) {
+			protocolNameValue = currentName
+		}
 	}
 
 	protocolValues := map[string]attr.Value{
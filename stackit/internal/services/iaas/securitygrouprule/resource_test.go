@@ -2,10 +2,17 @@ package securitygrouprule
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
@@ -181,6 +188,43 @@ func TestMapFields(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"protocol_name_keeps_configured_casing",
+			Model{
+				ProjectId:           types.StringValue("pid"),
+				SecurityGroupId:     types.StringValue("sgid"),
+				SecurityGroupRuleId: types.StringValue("sgrid"),
+				Protocol: types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+					"name":   types.StringValue("TCP"),
+					"number": types.Int64Value(6),
+				}),
+			},
+			&iaas.SecurityGroupRule{
+				Id: utils.Ptr("sgrid"),
+				Protocol: &iaas.Protocol{
+					Name:   utils.Ptr("tcp"),
+					Number: utils.Ptr(int64(6)),
+				},
+			},
+			Model{
+				Id:                    types.StringValue("pid,sgid,sgrid"),
+				ProjectId:             types.StringValue("pid"),
+				SecurityGroupId:       types.StringValue("sgid"),
+				SecurityGroupRuleId:   types.StringValue("sgrid"),
+				Direction:             types.StringNull(),
+				Description:           types.StringNull(),
+				EtherType:             types.StringNull(),
+				IpRange:               types.StringNull(),
+				RemoteSecurityGroupId: types.StringNull(),
+				IcmpParameters:        types.ObjectNull(icmpParametersTypes),
+				PortRange:             types.ObjectNull(portRangeTypes),
+				Protocol: types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+					"name":   types.StringValue("TCP"),
+					"number": types.Int64Value(6),
+				}),
+			},
+			true,
+		},
 		{
 			"response_nil_fail",
 			Model{},
@@ -303,3 +347,167 @@ func TestToCreatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanModifiersRequireReplace(t *testing.T) {
+	icmpProtocol := types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+		"name":   types.StringValue("icmp"),
+		"number": types.Int64Value(1),
+	})
+	stateModel := ResourceModel{
+		Model: Model{
+			Id:                    types.StringValue("pid,sgid,sgrid"),
+			ProjectId:             types.StringValue("pid"),
+			SecurityGroupId:       types.StringValue("sgid"),
+			SecurityGroupRuleId:   types.StringValue("sgrid"),
+			Direction:             types.StringValue("ingress"),
+			Description:           types.StringValue("description"),
+			EtherType:             types.StringValue("IPv4"),
+			IcmpParameters:        fixtureModelIcmpParameters,
+			IpRange:               types.StringNull(),
+			PortRange:             types.ObjectNull(portRangeTypes),
+			Protocol:              icmpProtocol,
+			RemoteSecurityGroupId: types.StringNull(),
+		},
+		Ports: types.StringNull(),
+	}
+	tests := []struct {
+		description     string
+		attribute       string
+		configure       func(config *ResourceModel)
+		plannedValue    attr.Value
+		requiresReplace bool
+	}{
+		{
+			"description_removed",
+			"description",
+			func(config *ResourceModel) { config.Description = types.StringNull() },
+			types.StringNull(),
+			true,
+		},
+		{
+			"description_changed",
+			"description",
+			func(config *ResourceModel) { config.Description = types.StringValue("other") },
+			types.StringValue("other"),
+			true,
+		},
+		{
+			"ether_type_removed",
+			"ether_type",
+			func(config *ResourceModel) { config.EtherType = types.StringNull() },
+			types.StringValue("IPv4"),
+			false,
+		},
+		{
+			"ether_type_changed",
+			"ether_type",
+			func(config *ResourceModel) { config.EtherType = types.StringValue("IPv6") },
+			types.StringValue("IPv6"),
+			true,
+		},
+		{
+			"icmp_parameters_removed",
+			"icmp_parameters",
+			func(config *ResourceModel) { config.IcmpParameters = types.ObjectNull(icmpParametersTypes) },
+			fixtureModelIcmpParameters,
+			false,
+		},
+		{
+			"icmp_parameters_changed",
+			"icmp_parameters",
+			func(config *ResourceModel) {
+				config.IcmpParameters = types.ObjectValueMust(icmpParametersTypes, map[string]attr.Value{
+					"code": types.Int64Value(0),
+					"type": types.Int64Value(8),
+				})
+			},
+			types.ObjectValueMust(icmpParametersTypes, map[string]attr.Value{
+				"code": types.Int64Value(0),
+				"type": types.Int64Value(8),
+			}),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			schemaResp := &resource.SchemaResponse{}
+			NewSecurityGroupRuleResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			resourceSchema := schemaResp.Schema
+
+			configModel := stateModel
+			tt.configure(&configModel)
+			// Terraform plans unknown for computed attributes which are not configured
+			planModel := configModel
+			if planModel.EtherType.IsNull() {
+				planModel.EtherType = types.StringUnknown()
+			}
+			if planModel.IcmpParameters.IsNull() {
+				planModel.IcmpParameters = types.ObjectUnknown(icmpParametersTypes)
+			}
+
+			state := tfsdk.State{Schema: resourceSchema}
+			plan := tfsdk.Plan{Schema: resourceSchema}
+			config := tfsdk.Config{Schema: resourceSchema}
+			// tfsdk.Config can't be set from a model, so the value is built as a state first
+			configState := tfsdk.State{Schema: resourceSchema}
+			diags := state.Set(ctx, &stateModel)
+			diags.Append(plan.Set(ctx, &planModel)...)
+			diags.Append(configState.Set(ctx, &configModel)...)
+			if diags.HasError() {
+				t.Fatalf("Setting up the request: %v", diags.Errors())
+			}
+			config.Raw = configState.Raw
+
+			plannedValue, requiresReplace, diags := runPlanModifiers(ctx, resourceSchema.Attributes[tt.attribute], path.Root(tt.attribute), config, plan, state)
+			if diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			diff := cmp.Diff(plannedValue, tt.plannedValue)
+			if diff != "" {
+				t.Fatalf("Planned value does not match: %s", diff)
+			}
+			if requiresReplace != tt.requiresReplace {
+				t.Fatalf("Expected requires replace to be %t, got %t", tt.requiresReplace, requiresReplace)
+			}
+		})
+	}
+}
+
+// runPlanModifiers runs the plan modifiers of a string or object attribute like Terraform does during planning
+func runPlanModifiers(ctx context.Context, attribute schema.Attribute, attributePath path.Path, config tfsdk.Config, plan tfsdk.Plan, state tfsdk.State) (attr.Value, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	requiresReplace := false
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		var configValue, planValue, stateValue types.String
+		diags.Append(config.GetAttribute(ctx, attributePath, &configValue)...)
+		diags.Append(plan.GetAttribute(ctx, attributePath, &planValue)...)
+		diags.Append(state.GetAttribute(ctx, attributePath, &stateValue)...)
+		for _, modifier := range a.PlanModifiers {
+			req := planmodifier.StringRequest{Path: attributePath, Config: config, ConfigValue: configValue, Plan: plan, PlanValue: planValue, State: state, StateValue: stateValue}
+			resp := &planmodifier.StringResponse{PlanValue: planValue}
+			modifier.PlanModifyString(ctx, req, resp)
+			diags.Append(resp.Diagnostics...)
+			planValue = resp.PlanValue
+			requiresReplace = requiresReplace || resp.RequiresReplace
+		}
+		return planValue, requiresReplace, diags
+	case schema.SingleNestedAttribute:
+		var configValue, planValue, stateValue types.Object
+		diags.Append(config.GetAttribute(ctx, attributePath, &configValue)...)
+		diags.Append(plan.GetAttribute(ctx, attributePath, &planValue)...)
+		diags.Append(state.GetAttribute(ctx, attributePath, &stateValue)...)
+		for _, modifier := range a.PlanModifiers {
+			req := planmodifier.ObjectRequest{Path: attributePath, Config: config, ConfigValue: configValue, Plan: plan, PlanValue: planValue, State: state, StateValue: stateValue}
+			resp := &planmodifier.ObjectResponse{PlanValue: planValue}
+			modifier.PlanModifyObject(ctx, req, resp)
+			diags.Append(resp.Diagnostics...)
+			planValue = resp.PlanValue
+			requiresReplace = requiresReplace || resp.RequiresReplace
+		}
+		return planValue, requiresReplace, diags
+	}
+	diags.AddError("Unsupported attribute", fmt.Sprintf("%T", attribute))
+	return nil, false, diags
+}
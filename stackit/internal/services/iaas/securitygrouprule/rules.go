@@ -0,0 +1,821 @@
+package securitygrouprule
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &securityGroupRulesResource{}
+	_ resource.ResourceWithConfigure      = &securityGroupRulesResource{}
+	_ resource.ResourceWithImportState    = &securityGroupRulesResource{}
+	_ resource.ResourceWithValidateConfig = &securityGroupRulesResource{}
+)
+
+const (
+	etherTypeIPv4 = "IPv4"
+	etherTypeIPv6 = "IPv6"
+	// The API assumes IPv4 if no ethertype is given on creation.
+	defaultEtherType = etherTypeIPv4
+)
+
+var etherTypeOptions = []string{etherTypeIPv4, etherTypeIPv6}
+
+type RulesModel struct {
+	Id              types.String `tfsdk:"id"` // needed by TF
+	ProjectId       types.String `tfsdk:"project_id"`
+	SecurityGroupId types.String `tfsdk:"security_group_id"`
+	Rules           types.Set    `tfsdk:"rules"`
+}
+
+// ruleModel is a single element of the rules set
+type ruleModel struct {
+	Direction             types.String `tfsdk:"direction"`
+	Description           types.String `tfsdk:"description"`
+	EtherType             types.String `tfsdk:"ether_type"`
+	IcmpParameters        types.Object `tfsdk:"icmp_parameters"`
+	IpRange               types.String `tfsdk:"ip_range"`
+	PortRange             types.Object `tfsdk:"port_range"`
+	Protocol              types.Object `tfsdk:"protocol"`
+	RemoteSecurityGroupId types.String `tfsdk:"remote_security_group_id"`
+}
+
+// Types corresponding to ruleModel
+var ruleTypes = map[string]attr.Type{
+	"direction":                basetypes.StringType{},
+	"description":              basetypes.StringType{},
+	"ether_type":               basetypes.StringType{},
+	"icmp_parameters":          basetypes.ObjectType{AttrTypes: icmpParametersTypes},
+	"ip_range":                 basetypes.StringType{},
+	"port_range":               basetypes.ObjectType{AttrTypes: portRangeTypes},
+	"protocol":                 basetypes.ObjectType{AttrTypes: protocolTypes},
+	"remote_security_group_id": basetypes.StringType{},
+}
+
+// NewSecurityGroupRulesResource is a helper function to simplify the provider implementation.
+func NewSecurityGroupRulesResource() resource.Resource {
+	return &securityGroupRulesResource{}
+}
+
+// securityGroupRulesResource is the resource implementation.
+type securityGroupRulesResource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *securityGroupRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_group_rules"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *securityGroupRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+func (r *securityGroupRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model RulesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Rules.IsNull() || model.Rules.IsUnknown() {
+		return
+	}
+
+	rules := []ruleModel{}
+	resp.Diagnostics.Append(model.Rules.ElementsAs(ctx, &rules, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		var protocolName *string
+		var protocolNumber *int64
+		if !(rule.Protocol.IsNull() || rule.Protocol.IsUnknown()) {
+			protocol := &protocolModel{}
+			resp.Diagnostics.Append(rule.Protocol.As(ctx, protocol, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			protocolName = conversion.StringValueToPointer(protocol.Name)
+			protocolNumber = conversion.Int64ValueToPointer(protocol.Number)
+		}
+
+		if err := validateEtherType(conversion.StringValueToPointer(rule.EtherType), conversion.StringValueToPointer(rule.IpRange), protocolName, protocolNumber); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules"),
+				"Invalid attribute configuration",
+				fmt.Sprintf("%v (rule with direction %q)", err, rule.Direction.ValueString()),
+			)
+		}
+
+		if protocolName == nil && protocolNumber == nil {
+			continue
+		}
+
+		if isIcmpProtocol(protocolName, protocolNumber) {
+			if !(rule.PortRange.IsNull() || rule.PortRange.IsUnknown()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("rules"),
+					"Conflicting attribute configuration",
+					fmt.Sprintf("`port_range` can't be provided if the protocol is `icmp` or `ipv6-icmp` (rule with direction %q)", rule.Direction.ValueString()),
+				)
+			}
+			if rule.IcmpParameters.IsNull() || rule.IcmpParameters.IsUnknown() {
+				continue
+			}
+			icmpParameters := &icmpParametersModel{}
+			resp.Diagnostics.Append(rule.IcmpParameters.As(ctx, icmpParameters, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if icmpParameters.Type.IsUnknown() || icmpParameters.Code.IsUnknown() {
+				continue
+			}
+			name, _ := resolveProtocolName(protocolName, protocolNumber)
+			if err := validateIcmpParameters(name, icmpParameters.Type.ValueInt64(), icmpParameters.Code.ValueInt64()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("rules"),
+					"Invalid attribute configuration",
+					fmt.Sprintf("%v (rule with direction %q)", err, rule.Direction.ValueString()),
+				)
+			}
+		} else if !(rule.IcmpParameters.IsNull() || rule.IcmpParameters.IsUnknown()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules"),
+				"Conflicting attribute configuration",
+				fmt.Sprintf("`icmp_parameters` can't be provided if the protocol is not `icmp` or `ipv6-icmp` (rule with direction %q)", rule.Direction.ValueString()),
+			)
+		}
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *securityGroupRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Security group rules resource schema. Manages all rules of a security group authoritatively: rules which are not configured in `rules` are deleted, and rules added outside of Terraform are reported as drift. Must have a `region` specified in the provider configuration."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description + "\n\n" +
+			"~> Do not use this resource together with `stackit_security_group_rule` resources for the same security group, they will delete each other's rules. " +
+			"Default rules created by the API together with a security group (e.g. egress rules) are deleted as well, unless they are part of `rules`.",
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`security_group_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the security group is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Description: "The security group ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"rules": schema.SetNestedAttribute{
+				Description: "The complete set of rules of the security group.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Description: "The rule description.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(127),
+							},
+						},
+						"direction": schema.StringAttribute{
+							Description: "The direction of the traffic which the rule should match. " + utils.SupportedValuesDocumentation(directionOptions),
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(directionOptions...),
+							},
+						},
+						"ether_type": schema.StringAttribute{
+							Description: fmt.Sprintf("The ethertype which the rule should match. Defaults to the address family of `ip_range`, or to `%s` if neither is set. %s", defaultEtherType, utils.SupportedValuesDocumentation(etherTypeOptions)),
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(etherTypeOptions...),
+							},
+						},
+						"icmp_parameters": schema.SingleNestedAttribute{
+							Description: "ICMP Parameters. These parameters should only be provided if the protocol is ICMP.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"code": schema.Int64Attribute{
+									Description: "ICMP code. Can be set if the protocol is ICMP.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(255),
+									},
+								},
+								"type": schema.Int64Attribute{
+									Description: "ICMP type. Can be set if the protocol is ICMP.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(255),
+									},
+								},
+							},
+						},
+						"ip_range": schema.StringAttribute{
+							Description: "The remote IP range which the rule should match.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(ipRangeRegex, "must match expression"),
+							},
+						},
+						"port_range": schema.SingleNestedAttribute{
+							Description: "The range of ports. This should only be provided if the protocol is not ICMP.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"max": schema.Int64Attribute{
+									Description: "The maximum port number. Should be greater or equal to the minimum.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+								"min": schema.Int64Attribute{
+									Description: "The minimum port number. Should be less or equal to the maximum.",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+							},
+						},
+						"protocol": schema.SingleNestedAttribute{
+							Description: "The internet protocol which the rule should match.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: fmt.Sprintf("The protocol name which the rule should match. Either `name` or `number` must be provided. %s", utils.FormatPossibleValues(protocolsPossibleValues...)),
+									Optional:    true,
+									Validators: []validator.String{
+										stringvalidator.AtLeastOneOf(
+											path.MatchRelative().AtParent().AtName("number"),
+										),
+										stringvalidator.ConflictsWith(
+											path.MatchRelative().AtParent().AtName("number"),
+										),
+										stringvalidator.OneOfCaseInsensitive(protocolsPossibleValues...),
+									},
+								},
+								"number": schema.Int64Attribute{
+									Description: "The protocol number which the rule should match. Either `name` or `number` must be provided.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(255),
+									},
+								},
+							},
+						},
+						"remote_security_group_id": schema.StringAttribute{
+							Description: "The remote security group which the rule should match.",
+							Optional:    true,
+							Validators: []validator.String{
+								validate.UUID(),
+								validate.NoSeparator(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *securityGroupRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model RulesModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	err := r.reconcileRules(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating security group rules", fmt.Sprintf("Reconciling rules: %v", err))
+		return
+	}
+
+	model.Id = utils.BuildInternalTerraformId(projectId, securityGroupId)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Security group rules created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *securityGroupRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model RulesModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	rulesResp, err := r.client.ListSecurityGroupRulesExecute(ctx, projectId, securityGroupId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group rules", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapRulesFields(ctx, rulesResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading security group rules", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "security group rules read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *securityGroupRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model RulesModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	err := r.reconcileRules(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating security group rules", fmt.Sprintf("Reconciling rules: %v", err))
+		return
+	}
+
+	model.Id = utils.BuildInternalTerraformId(projectId, securityGroupId)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "security group rules updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// Only the rules which are known to the state are deleted.
+func (r *securityGroupRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model RulesModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	rulesResp, err := r.client.ListSecurityGroupRulesExecute(ctx, projectId, securityGroupId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "security group already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting security group rules", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	managed, err := toRulePayloads(ctx, model.Rules)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting security group rules", fmt.Sprintf("Processing state: %v", err))
+		return
+	}
+	for _, rule := range matchedRules(managed, rulesResp.GetItems()) {
+		if rule.Id == nil {
+			continue
+		}
+		err = r.client.DeleteSecurityGroupRule(ctx, projectId, securityGroupId, *rule.Id).Execute()
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting security group rules", fmt.Sprintf("Deleting rule %q: %v", *rule.Id, err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "security group rules deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,security_group_id
+func (r *securityGroupRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing security group rules",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[security_group_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	projectId := idParts[0]
+	securityGroupId := idParts[1]
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("security_group_id"), securityGroupId)...)
+	tflog.Info(ctx, "security group rules state imported")
+}
+
+// reconcileRules lists the current rules of the security group and creates and deletes
+// only the rules which differ from the planned ones.
+func (r *securityGroupRulesResource) reconcileRules(ctx context.Context, model *RulesModel) error {
+	projectId := model.ProjectId.ValueString()
+	securityGroupId := model.SecurityGroupId.ValueString()
+
+	desired, err := toRulePayloads(ctx, model.Rules)
+	if err != nil {
+		return fmt.Errorf("creating API payload: %w", err)
+	}
+
+	rulesResp, err := r.client.ListSecurityGroupRulesExecute(ctx, projectId, securityGroupId)
+	if err != nil {
+		return fmt.Errorf("listing current rules: %w", err)
+	}
+
+	toCreate, toDelete := diffRules(desired, rulesResp.GetItems())
+	tflog.Debug(ctx, "security group rules diff", map[string]any{"create": len(toCreate), "delete": len(toDelete)})
+
+	// Delete first, so that replaced rules don't collide with their successors
+	for _, ruleId := range toDelete {
+		err = r.client.DeleteSecurityGroupRule(ctx, projectId, securityGroupId, ruleId).Execute()
+		if err != nil {
+			return fmt.Errorf("deleting rule %q: %w", ruleId, err)
+		}
+	}
+	for _, payload := range toCreate {
+		_, err = r.client.CreateSecurityGroupRule(ctx, projectId, securityGroupId).CreateSecurityGroupRulePayload(*payload).Execute()
+		if err != nil {
+			return fmt.Errorf("creating rule: %w", err)
+		}
+	}
+	return nil
+}
+
+// toRulePayloads converts the rules set into create payloads, reusing the conversion of the single rule resource.
+func toRulePayloads(ctx context.Context, rulesSet types.Set) ([]*iaas.CreateSecurityGroupRulePayload, error) {
+	if rulesSet.IsNull() || rulesSet.IsUnknown() {
+		return nil, nil
+	}
+
+	rules := []ruleModel{}
+	diags := rulesSet.ElementsAs(ctx, &rules, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading rules: %w", core.DiagsToError(diags))
+	}
+
+	payloads := make([]*iaas.CreateSecurityGroupRulePayload, 0, len(rules))
+	for i := range rules {
+		payload, err := toRulePayload(ctx, &rules[i])
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		payloads = append(payloads, payload)
+	}
+	return payloads, nil
+}
+
+func toRulePayload(ctx context.Context, rule *ruleModel) (*iaas.CreateSecurityGroupRulePayload, error) {
+	var icmpParameters *icmpParametersModel
+	if !(rule.IcmpParameters.IsNull() || rule.IcmpParameters.IsUnknown()) {
+		icmpParameters = &icmpParametersModel{}
+		diags := rule.IcmpParameters.As(ctx, icmpParameters, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("reading icmp_parameters: %w", core.DiagsToError(diags))
+		}
+	}
+
+	var portRange *portRangeModel
+	if !(rule.PortRange.IsNull() || rule.PortRange.IsUnknown()) {
+		portRange = &portRangeModel{}
+		diags := rule.PortRange.As(ctx, portRange, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("reading port_range: %w", core.DiagsToError(diags))
+		}
+	}
+
+	var protocol *protocolModel
+	if !(rule.Protocol.IsNull() || rule.Protocol.IsUnknown()) {
+		protocol = &protocolModel{}
+		diags := rule.Protocol.As(ctx, protocol, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("reading protocol: %w", core.DiagsToError(diags))
+		}
+	}
+
+	// Send the ethertype matching the IP range, the API would assume IPv4 otherwise
+	etherType := rule.EtherType
+	if etherType.IsNull() && !(rule.IpRange.IsNull() || rule.IpRange.IsUnknown()) {
+		if ipRangeEtherType, ok := etherTypeForIpRange(rule.IpRange.ValueString()); ok {
+			etherType = types.StringValue(ipRangeEtherType)
+		}
+	}
+
+	return toCreatePayload(&Model{
+		Direction:             rule.Direction,
+		Description:           rule.Description,
+		EtherType:             etherType,
+		IpRange:               rule.IpRange,
+		RemoteSecurityGroupId: rule.RemoteSecurityGroupId,
+	}, icmpParameters, portRange, protocol)
+}
+
+// diffRules returns the rules which have to be created and the IDs of the rules which have to be deleted
+// to get from the actual to the desired rules. Every actual rule satisfies at most one desired rule.
+func diffRules(desired []*iaas.CreateSecurityGroupRulePayload, actual []iaas.SecurityGroupRule) (toCreate []*iaas.CreateSecurityGroupRulePayload, toDelete []string) {
+	matched := make([]bool, len(actual))
+	for _, payload := range desired {
+		idx := findMatchingRule(payload, actual, matched)
+		if idx < 0 {
+			toCreate = append(toCreate, payload)
+			continue
+		}
+		matched[idx] = true
+	}
+	for i := range actual {
+		if !matched[i] && actual[i].Id != nil {
+			toDelete = append(toDelete, *actual[i].Id)
+		}
+	}
+	return toCreate, toDelete
+}
+
+// matchedRules returns the actual rules which satisfy one of the given payloads.
+func matchedRules(payloads []*iaas.CreateSecurityGroupRulePayload, actual []iaas.SecurityGroupRule) []iaas.SecurityGroupRule {
+	matched := make([]bool, len(actual))
+	result := []iaas.SecurityGroupRule{}
+	for _, payload := range payloads {
+		idx := findMatchingRule(payload, actual, matched)
+		if idx < 0 {
+			continue
+		}
+		matched[idx] = true
+		result = append(result, actual[idx])
+	}
+	return result
+}
+
+func findMatchingRule(payload *iaas.CreateSecurityGroupRulePayload, actual []iaas.SecurityGroupRule, matched []bool) int {
+	for i := range actual {
+		if !matched[i] && ruleMatches(payload, &actual[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ruleMatches reports whether an existing rule satisfies the rule described by payload.
+// Unset optional fields are treated as their API defaults.
+func ruleMatches(payload *iaas.CreateSecurityGroupRulePayload, rule *iaas.SecurityGroupRule) bool {
+	if payload == nil || rule == nil {
+		return false
+	}
+	if valueOrDefault(payload.Direction, "") != valueOrDefault(rule.Direction, "") ||
+		valueOrDefault(payload.Description, "") != valueOrDefault(rule.Description, "") ||
+		valueOrDefault(payload.Ethertype, defaultEtherType) != valueOrDefault(rule.Ethertype, defaultEtherType) ||
+		canonicalIpRange(valueOrDefault(payload.IpRange, "")) != canonicalIpRange(valueOrDefault(rule.IpRange, "")) ||
+		valueOrDefault(payload.RemoteSecurityGroupId, "") != valueOrDefault(rule.RemoteSecurityGroupId, "") {
+		return false
+	}
+
+	if (payload.IcmpParameters == nil) != (rule.IcmpParameters == nil) {
+		return false
+	}
+	if payload.IcmpParameters != nil &&
+		(valueOrDefault(payload.IcmpParameters.Code, -1) != valueOrDefault(rule.IcmpParameters.Code, -1) ||
+			valueOrDefault(payload.IcmpParameters.Type, -1) != valueOrDefault(rule.IcmpParameters.Type, -1)) {
+		return false
+	}
+
+	if (payload.PortRange == nil) != (rule.PortRange == nil) {
+		return false
+	}
+	if payload.PortRange != nil &&
+		(valueOrDefault(payload.PortRange.Min, -1) != valueOrDefault(rule.PortRange.Min, -1) ||
+			valueOrDefault(payload.PortRange.Max, -1) != valueOrDefault(rule.PortRange.Max, -1)) {
+		return false
+	}
+
+	if payload.Protocol == nil || (payload.Protocol.String == nil && payload.Protocol.Int64 == nil) {
+		return rule.Protocol == nil || (rule.Protocol.Name == nil && rule.Protocol.Number == nil)
+	}
+	if rule.Protocol == nil {
+		return false
+	}
+	return sameProtocol(payload.Protocol.String, payload.Protocol.Int64, rule.Protocol.Name, rule.Protocol.Number)
+}
+
+// canonicalIpRange returns the IP range with its host bits cleared, e.g. 10.0.0.0/24 for 10.0.0.1/24, as the API returns it.
+// Values which can't be parsed are returned unchanged.
+func canonicalIpRange(ipRange string) string {
+	prefix, err := netip.ParsePrefix(ipRange)
+	if err != nil {
+		return ipRange
+	}
+	return prefix.Masked().String()
+}
+
+func valueOrDefault[T comparable](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+// mapRulesFields maps the actual rules of the security group to the rules set.
+// Rules which satisfy a rule of the current set keep their configured representation,
+// all other rules are added as reported by the API so that they show up as drift.
+func mapRulesFields(ctx context.Context, rulesResp *iaas.SecurityGroupRuleListResponse, model *RulesModel) error {
+	if rulesResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.SecurityGroupId.ValueString())
+
+	current := []ruleModel{}
+	if !(model.Rules.IsNull() || model.Rules.IsUnknown()) {
+		diags := model.Rules.ElementsAs(ctx, &current, false)
+		if diags.HasError() {
+			return fmt.Errorf("reading rules: %w", core.DiagsToError(diags))
+		}
+	}
+	payloads := make([]*iaas.CreateSecurityGroupRulePayload, 0, len(current))
+	for i := range current {
+		payload, err := toRulePayload(ctx, &current[i])
+		if err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+		payloads = append(payloads, payload)
+	}
+
+	used := make([]bool, len(current))
+	rules := []attr.Value{}
+	for i := range rulesResp.GetItems() {
+		rule := &rulesResp.GetItems()[i]
+
+		var ruleData *ruleModel
+		for j, payload := range payloads {
+			if !used[j] && ruleMatches(payload, rule) {
+				used[j] = true
+				ruleData = &current[j]
+				break
+			}
+		}
+		if ruleData == nil {
+			m := &Model{}
+			if err := mapIcmpParameters(rule, m); err != nil {
+				return fmt.Errorf("map icmp_parameters: %w", err)
+			}
+			if err := mapPortRange(rule, m); err != nil {
+				return fmt.Errorf("map port_range: %w", err)
+			}
+			if err := mapProtocol(rule, m); err != nil {
+				return fmt.Errorf("map protocol: %w", err)
+			}
+			ruleData = &ruleModel{
+				Direction:             types.StringPointerValue(rule.Direction),
+				Description:           types.StringPointerValue(rule.Description),
+				EtherType:             types.StringPointerValue(rule.Ethertype),
+				IcmpParameters:        m.IcmpParameters,
+				IpRange:               types.StringPointerValue(rule.IpRange),
+				PortRange:             m.PortRange,
+				Protocol:              m.Protocol,
+				RemoteSecurityGroupId: types.StringPointerValue(rule.RemoteSecurityGroupId),
+			}
+		}
+
+		ruleValue, diags := types.ObjectValue(ruleTypes, ruleToAttrValues(ruleData))
+		if diags.HasError() {
+			return fmt.Errorf("create rule object: %w", core.DiagsToError(diags))
+		}
+
+		// Identical rules collapse into a single set element
+		if slices.ContainsFunc(rules, ruleValue.Equal) {
+			continue
+		}
+		rules = append(rules, ruleValue)
+	}
+
+	rulesSet, diags := types.SetValue(types.ObjectType{AttrTypes: ruleTypes}, rules)
+	if diags.HasError() {
+		return fmt.Errorf("create rules set: %w", core.DiagsToError(diags))
+	}
+	model.Rules = rulesSet
+	return nil
+}
+
+func ruleToAttrValues(rule *ruleModel) map[string]attr.Value {
+	return map[string]attr.Value{
+		"direction":                rule.Direction,
+		"description":              rule.Description,
+		"ether_type":               rule.EtherType,
+		"icmp_parameters":          rule.IcmpParameters,
+		"ip_range":                 rule.IpRange,
+		"port_range":               rule.PortRange,
+		"protocol":                 rule.Protocol,
+		"remote_security_group_id": rule.RemoteSecurityGroupId,
+	}
+}
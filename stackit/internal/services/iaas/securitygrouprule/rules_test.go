@@ -0,0 +1,487 @@
+package securitygrouprule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+var fixtureRuleObjectType = types.ObjectType{AttrTypes: ruleTypes}
+
+func fixtureRule(mods ...func(m map[string]attr.Value)) attr.Value {
+	values := map[string]attr.Value{
+		"direction":                types.StringValue("ingress"),
+		"description":              types.StringNull(),
+		"ether_type":               types.StringNull(),
+		"icmp_parameters":          types.ObjectNull(icmpParametersTypes),
+		"ip_range":                 types.StringNull(),
+		"port_range":               types.ObjectNull(portRangeTypes),
+		"protocol":                 types.ObjectNull(protocolTypes),
+		"remote_security_group_id": types.StringNull(),
+	}
+	for _, mod := range mods {
+		mod(values)
+	}
+	return types.ObjectValueMust(ruleTypes, values)
+}
+
+func TestRuleMatches(t *testing.T) {
+	tests := []struct {
+		description string
+		payload     *iaas.CreateSecurityGroupRulePayload
+		rule        *iaas.SecurityGroupRule
+		expected    bool
+	}{
+		{
+			"default_ether_type",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+			},
+			&iaas.SecurityGroupRule{
+				Id:        utils.Ptr("rid"),
+				Direction: utils.Ptr("ingress"),
+				Ethertype: utils.Ptr("IPv4"),
+			},
+			true,
+		},
+		{
+			"non_canonical_ip_range",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				IpRange:   utils.Ptr("10.0.0.1/24"),
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("ingress"),
+				IpRange:   utils.Ptr("10.0.0.0/24"),
+			},
+			true,
+		},
+		{
+			"non_canonical_ipv6_range",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				Ethertype: utils.Ptr("IPv6"),
+				IpRange:   utils.Ptr("2001:0db8::1/64"),
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("ingress"),
+				Ethertype: utils.Ptr("IPv6"),
+				IpRange:   utils.Ptr("2001:db8::/64"),
+			},
+			true,
+		},
+		{
+			"different_ip_range",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				IpRange:   utils.Ptr("10.0.0.0/24"),
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("ingress"),
+				IpRange:   utils.Ptr("10.0.0.0/16"),
+			},
+			false,
+		},
+		{
+			"protocol_name",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.CreateProtocol{String: utils.Ptr("tcp")},
+				PortRange: &iaas.PortRange{Min: utils.Ptr(int64(22)), Max: utils.Ptr(int64(22))},
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.Protocol{Name: utils.Ptr("tcp"), Number: utils.Ptr(int64(6))},
+				PortRange: &iaas.PortRange{Min: utils.Ptr(int64(22)), Max: utils.Ptr(int64(22))},
+			},
+			true,
+		},
+		{
+			"protocol_number",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.CreateProtocol{Int64: utils.Ptr(int64(6))},
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.Protocol{Name: utils.Ptr("tcp"), Number: utils.Ptr(int64(6))},
+			},
+			true,
+		},
+		{
+			"protocol_name_casing",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.CreateProtocol{String: utils.Ptr("UDP")},
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.Protocol{Name: utils.Ptr("udp")},
+			},
+			true,
+		},
+		{
+			"protocol_number_against_name_only",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.CreateProtocol{Int64: utils.Ptr(int64(1))},
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.Protocol{Name: utils.Ptr("icmp")},
+			},
+			true,
+		},
+		{
+			"different_port_range",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				PortRange: &iaas.PortRange{Min: utils.Ptr(int64(22)), Max: utils.Ptr(int64(22))},
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("ingress"),
+				PortRange: &iaas.PortRange{Min: utils.Ptr(int64(22)), Max: utils.Ptr(int64(23))},
+			},
+			false,
+		},
+		{
+			"missing_icmp_parameters",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				Protocol:  &iaas.CreateProtocol{String: utils.Ptr("icmp")},
+			},
+			&iaas.SecurityGroupRule{
+				Direction:      utils.Ptr("ingress"),
+				Protocol:       &iaas.Protocol{Name: utils.Ptr("icmp")},
+				IcmpParameters: &iaas.ICMPParameters{Code: utils.Ptr(int64(0)), Type: utils.Ptr(int64(8))},
+			},
+			false,
+		},
+		{
+			"any_protocol_vs_protocol",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("egress"),
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("egress"),
+				Protocol:  &iaas.Protocol{Name: utils.Ptr("udp")},
+			},
+			false,
+		},
+		{
+			"different_direction",
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+			},
+			&iaas.SecurityGroupRule{
+				Direction: utils.Ptr("egress"),
+			},
+			false,
+		},
+		{
+			"nil_rule",
+			&iaas.CreateSecurityGroupRulePayload{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := ruleMatches(tt.payload, tt.rule)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestToRulePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       ruleModel
+		expected    *iaas.CreateSecurityGroupRulePayload
+	}{
+		{
+			"no_ip_range",
+			ruleModel{
+				Direction: types.StringValue("ingress"),
+			},
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+			},
+		},
+		{
+			"ethertype_from_ipv6_range",
+			ruleModel{
+				Direction: types.StringValue("ingress"),
+				IpRange:   types.StringValue("2001:db8::/32"),
+			},
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("ingress"),
+				Ethertype: utils.Ptr("IPv6"),
+				IpRange:   utils.Ptr("2001:db8::/32"),
+			},
+		},
+		{
+			"configured_ethertype_kept",
+			ruleModel{
+				Direction: types.StringValue("egress"),
+				EtherType: types.StringValue("IPv4"),
+				IpRange:   types.StringValue("10.0.0.0/8"),
+			},
+			&iaas.CreateSecurityGroupRulePayload{
+				Direction: utils.Ptr("egress"),
+				Ethertype: utils.Ptr("IPv4"),
+				IpRange:   utils.Ptr("10.0.0.0/8"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toRulePayload(context.Background(), &tt.input)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffRules(t *testing.T) {
+	ssh := &iaas.CreateSecurityGroupRulePayload{
+		Direction: utils.Ptr("ingress"),
+		Protocol:  &iaas.CreateProtocol{String: utils.Ptr("tcp")},
+		PortRange: &iaas.PortRange{Min: utils.Ptr(int64(22)), Max: utils.Ptr(int64(22))},
+	}
+	egress := &iaas.CreateSecurityGroupRulePayload{
+		Direction: utils.Ptr("egress"),
+	}
+	actualSsh := iaas.SecurityGroupRule{
+		Id:        utils.Ptr("ssh"),
+		Direction: utils.Ptr("ingress"),
+		Ethertype: utils.Ptr("IPv4"),
+		Protocol:  &iaas.Protocol{Name: utils.Ptr("tcp"), Number: utils.Ptr(int64(6))},
+		PortRange: &iaas.PortRange{Min: utils.Ptr(int64(22)), Max: utils.Ptr(int64(22))},
+	}
+	actualEgress := iaas.SecurityGroupRule{
+		Id:        utils.Ptr("egress"),
+		Direction: utils.Ptr("egress"),
+		Ethertype: utils.Ptr("IPv4"),
+	}
+	actualEgressV6 := iaas.SecurityGroupRule{
+		Id:        utils.Ptr("egress-v6"),
+		Direction: utils.Ptr("egress"),
+		Ethertype: utils.Ptr("IPv6"),
+	}
+
+	tests := []struct {
+		description      string
+		desired          []*iaas.CreateSecurityGroupRulePayload
+		actual           []iaas.SecurityGroupRule
+		expectedCreate   []*iaas.CreateSecurityGroupRulePayload
+		expectedToDelete []string
+	}{
+		{
+			"in_sync",
+			[]*iaas.CreateSecurityGroupRulePayload{ssh, egress},
+			[]iaas.SecurityGroupRule{actualEgress, actualSsh},
+			nil,
+			nil,
+		},
+		{
+			"create_and_delete_delta",
+			[]*iaas.CreateSecurityGroupRulePayload{ssh},
+			[]iaas.SecurityGroupRule{actualEgress, actualEgressV6},
+			[]*iaas.CreateSecurityGroupRulePayload{ssh},
+			[]string{"egress", "egress-v6"},
+		},
+		{
+			"duplicate_actual_rule",
+			[]*iaas.CreateSecurityGroupRulePayload{egress},
+			[]iaas.SecurityGroupRule{actualEgress, actualEgress},
+			nil,
+			[]string{"egress"},
+		},
+		{
+			"empty_desired",
+			nil,
+			[]iaas.SecurityGroupRule{actualSsh},
+			nil,
+			[]string{"ssh"},
+		},
+		{
+			"empty_actual",
+			[]*iaas.CreateSecurityGroupRulePayload{ssh, egress},
+			nil,
+			[]*iaas.CreateSecurityGroupRulePayload{ssh, egress},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			toCreate, toDelete := diffRules(tt.desired, tt.actual)
+			diff := cmp.Diff(toCreate, tt.expectedCreate)
+			if diff != "" {
+				t.Fatalf("Rules to create don't match: %s", diff)
+			}
+			diff = cmp.Diff(toDelete, tt.expectedToDelete)
+			if diff != "" {
+				t.Fatalf("Rules to delete don't match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapRulesFields(t *testing.T) {
+	configuredSsh := fixtureRule(func(m map[string]attr.Value) {
+		m["protocol"] = types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+			"name":   types.StringValue("tcp"),
+			"number": types.Int64Null(),
+		})
+		m["port_range"] = types.ObjectValueMust(portRangeTypes, map[string]attr.Value{
+			"max": types.Int64Value(22),
+			"min": types.Int64Value(22),
+		})
+	})
+	actualSsh := iaas.SecurityGroupRule{
+		Id:        utils.Ptr("ssh"),
+		Direction: utils.Ptr("ingress"),
+		Ethertype: utils.Ptr("IPv4"),
+		Protocol:  &iaas.Protocol{Name: utils.Ptr("tcp"), Number: utils.Ptr(int64(6))},
+		PortRange: &iaas.PortRange{Min: utils.Ptr(int64(22)), Max: utils.Ptr(int64(22))},
+	}
+	actualEgress := iaas.SecurityGroupRule{
+		Id:        utils.Ptr("egress"),
+		Direction: utils.Ptr("egress"),
+		Ethertype: utils.Ptr("IPv6"),
+	}
+	unmanagedEgress := fixtureRule(func(m map[string]attr.Value) {
+		m["direction"] = types.StringValue("egress")
+		m["ether_type"] = types.StringValue("IPv6")
+	})
+
+	tests := []struct {
+		description string
+		state       RulesModel
+		input       *iaas.SecurityGroupRuleListResponse
+		expected    RulesModel
+		isValid     bool
+	}{
+		{
+			"keeps_configured_representation",
+			RulesModel{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.SetValueMust(fixtureRuleObjectType, []attr.Value{configuredSsh}),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{actualSsh},
+			},
+			RulesModel{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.SetValueMust(fixtureRuleObjectType, []attr.Value{configuredSsh}),
+			},
+			true,
+		},
+		{
+			"unmanaged_rule_is_drift",
+			RulesModel{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.SetValueMust(fixtureRuleObjectType, []attr.Value{configuredSsh}),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{actualSsh, actualEgress},
+			},
+			RulesModel{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.SetValueMust(fixtureRuleObjectType, []attr.Value{configuredSsh, unmanagedEgress}),
+			},
+			true,
+		},
+		{
+			"deleted_rule_is_drift",
+			RulesModel{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.SetValueMust(fixtureRuleObjectType, []attr.Value{configuredSsh, unmanagedEgress}),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{actualEgress},
+			},
+			RulesModel{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.SetValueMust(fixtureRuleObjectType, []attr.Value{unmanagedEgress}),
+			},
+			true,
+		},
+		{
+			"imported",
+			RulesModel{
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules:           types.SetNull(fixtureRuleObjectType),
+			},
+			&iaas.SecurityGroupRuleListResponse{
+				Items: &[]iaas.SecurityGroupRule{actualSsh},
+			},
+			RulesModel{
+				Id:              types.StringValue("pid,sgid"),
+				ProjectId:       types.StringValue("pid"),
+				SecurityGroupId: types.StringValue("sgid"),
+				Rules: types.SetValueMust(fixtureRuleObjectType, []attr.Value{
+					fixtureRule(func(m map[string]attr.Value) {
+						m["ether_type"] = types.StringValue("IPv4")
+						m["protocol"] = types.ObjectValueMust(protocolTypes, map[string]attr.Value{
+							"name":   types.StringValue("tcp"),
+							"number": types.Int64Value(6),
+						})
+						m["port_range"] = types.ObjectValueMust(portRangeTypes, map[string]attr.Value{
+							"max": types.Int64Value(22),
+							"min": types.Int64Value(22),
+						})
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			RulesModel{},
+			nil,
+			RulesModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapRulesFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -146,6 +146,31 @@ resource "stackit_server_volume_attach" "attach_volume" {
 }
 ` + "\n```" + `
 
+### Server with inline volume attachments` + "\n" +
+	"```terraform" + `
+resource "stackit_volume" "data" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  size              = 100
+  name              = "example-data-volume"
+  availability_zone = "eu01-1"
+}
+
+resource "stackit_server" "server-with-inline-volumes" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name       = "example-server"
+  boot_volume = {
+    # Increasing the size resizes the boot volume without recreating the server
+    size        = 64
+    source_type = "image"
+    source_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  availability_zone  = "eu01-1"
+  machine_type       = "g2i.1"
+  keypair_name       = stackit_key_pair.keypair.name
+  volume_attachments = [stackit_volume.data.volume_id]
+}
+` + "\n```" + `
+
 ### Server with user data (cloud-init)` + "\n" +
 	"```terraform" + `
 resource "stackit_server" "user-data" {
@@ -173,4 +198,74 @@ resource "stackit_server" "user-data-from-file" {
   keypair_name = stackit_key_pair.keypair.name
   user_data    = file("${path.module}/cloud-init.yaml")
 }
+
+data "stackit_cloudinit_config" "config" {
+  part = [
+    {
+      content = file("${path.module}/cloud-init.yaml")
+    },
+    {
+      content_type = "text/x-shellscript"
+      content      = "#!/bin/bash\necho hello"
+    },
+  ]
+}
+
+resource "stackit_server" "user-data-from-cloudinit-config" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  boot_volume = {
+    size        = 64
+    source_type = "image"
+    source_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  name             = "example-server"
+  machine_type     = "g2i.1"
+  keypair_name     = stackit_key_pair.keypair.name
+  user_data_base64 = data.stackit_cloudinit_config.config.rendered
+
+  # Keep the server when the user data changes, the new user data is used when the server is recreated
+  user_data_replace_on_change = false
+}
+` + "\n```" + `
+
+### Rescue mode and reboots` + "\n" +
+	"```terraform" + `
+resource "stackit_server" "rescue" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  boot_volume = {
+    size        = 64
+    source_type = "image"
+    source_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  name         = "example-server"
+  machine_type = "g2i.1"
+  keypair_name = stackit_key_pair.keypair.name
+
+  # Boots the server from the rescue image, remove the block to return to normal operation
+  rescue = {
+    image_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+}
+
+resource "stackit_server" "reboot" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  boot_volume = {
+    size        = 64
+    source_type = "image"
+    source_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  }
+  name         = "example-server"
+  machine_type = "g2i.1"
+  keypair_name = stackit_key_pair.keypair.name
+
+  # Change the value to reboot the server
+  reboot_trigger = "2025-01-01"
+  reboot_type    = "hard"
+}
+
+data "stackit_server_console" "console" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  server_id  = stackit_server.rescue.server_id
+  log_lines  = 50
+}
 ` + "\n```"
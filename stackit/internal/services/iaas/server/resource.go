@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,11 +14,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -29,6 +32,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+	sdkWait "github.com/stackitcloud/stackit-sdk-go/core/wait"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
@@ -42,35 +47,57 @@ var (
 	_ resource.Resource                = &serverResource{}
 	_ resource.ResourceWithConfigure   = &serverResource{}
 	_ resource.ResourceWithImportState = &serverResource{}
+	_ resource.ResourceWithModifyPlan  = &serverResource{}
 
 	supportedSourceTypes = []string{"volume", "image"}
 	desiredStatusOptions = []string{modelStateActive, modelStateInactive, modelStateDeallocated}
+	rebootTypeOptions    = []string{rebootTypeSoft, rebootTypeHard}
 )
 
 const (
 	modelStateActive      = "active"
 	modelStateInactive    = "inactive"
 	modelStateDeallocated = "deallocated"
+
+	rebootTypeSoft = "soft"
+	rebootTypeHard = "hard"
+
+	// rebootWaitGracePeriod is the time after which a server that is back in its previous status is considered rebooted,
+	// even if no other status was seen. A fast reboot can finish between two polls of the wait handler.
+	rebootWaitGracePeriod = 30 * time.Second
+
+	affinityGroupPolicyHardAffinity     = "hard-affinity"
+	affinityGroupPolicyHardAntiAffinity = "hard-anti-affinity"
+
+	// hardAntiAffinityGroupMemberLimit is the maximum number of servers in a hard-anti-affinity group.
+	// The IaaS API does not expose it, so the default of the underlying compute service is assumed.
+	hardAntiAffinityGroupMemberLimit = 10
 )
 
 type Model struct {
-	Id                types.String `tfsdk:"id"` // needed by TF
-	ProjectId         types.String `tfsdk:"project_id"`
-	ServerId          types.String `tfsdk:"server_id"`
-	MachineType       types.String `tfsdk:"machine_type"`
-	Name              types.String `tfsdk:"name"`
-	AvailabilityZone  types.String `tfsdk:"availability_zone"`
-	BootVolume        types.Object `tfsdk:"boot_volume"`
-	ImageId           types.String `tfsdk:"image_id"`
-	NetworkInterfaces types.List   `tfsdk:"network_interfaces"`
-	KeypairName       types.String `tfsdk:"keypair_name"`
-	Labels            types.Map    `tfsdk:"labels"`
-	AffinityGroup     types.String `tfsdk:"affinity_group"`
-	UserData          types.String `tfsdk:"user_data"`
-	CreatedAt         types.String `tfsdk:"created_at"`
-	LaunchedAt        types.String `tfsdk:"launched_at"`
-	UpdatedAt         types.String `tfsdk:"updated_at"`
-	DesiredStatus     types.String `tfsdk:"desired_status"`
+	Id                      types.String `tfsdk:"id"` // needed by TF
+	ProjectId               types.String `tfsdk:"project_id"`
+	ServerId                types.String `tfsdk:"server_id"`
+	MachineType             types.String `tfsdk:"machine_type"`
+	Name                    types.String `tfsdk:"name"`
+	AvailabilityZone        types.String `tfsdk:"availability_zone"`
+	BootVolume              types.Object `tfsdk:"boot_volume"`
+	ImageId                 types.String `tfsdk:"image_id"`
+	NetworkInterfaces       types.List   `tfsdk:"network_interfaces"`
+	KeypairName             types.String `tfsdk:"keypair_name"`
+	Labels                  types.Map    `tfsdk:"labels"`
+	AffinityGroup           types.String `tfsdk:"affinity_group"`
+	UserData                types.String `tfsdk:"user_data"`
+	UserDataBase64          types.String `tfsdk:"user_data_base64"`
+	UserDataReplaceOnChange types.Bool   `tfsdk:"user_data_replace_on_change"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+	LaunchedAt              types.String `tfsdk:"launched_at"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+	DesiredStatus           types.String `tfsdk:"desired_status"`
+	Rescue                  types.Object `tfsdk:"rescue"`
+	RebootTrigger           types.String `tfsdk:"reboot_trigger"`
+	RebootType              types.String `tfsdk:"reboot_type"`
+	VolumeAttachments       types.Set    `tfsdk:"volume_attachments"`
 }
 
 // Struct corresponding to Model.BootVolume
@@ -93,6 +120,16 @@ var bootVolumeTypes = map[string]attr.Type{
 	"id":                    basetypes.StringType{},
 }
 
+// Struct corresponding to Model.Rescue
+type rescueModel struct {
+	ImageId types.String `tfsdk:"image_id"`
+}
+
+// Types corresponding to rescueModel
+var rescueTypes = map[string]attr.Type{
+	"image_id": basetypes.StringType{},
+}
+
 // NewServerResource is a helper function to simplify the provider implementation.
 func NewServerResource() resource.Resource {
 	return &serverResource{}
@@ -129,6 +166,11 @@ func (r serverResource) ValidateConfig(ctx context.Context, req resource.Validat
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring server", "You can only provide `delete_on_termination` for `source_type` `image`.")
 		}
 	}
+
+	// A server can only be rescued while it is running
+	if !model.Rescue.IsNull() && !model.DesiredStatus.IsUnknown() && !model.DesiredStatus.IsNull() && model.DesiredStatus.ValueString() != modelStateActive {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring server", fmt.Sprintf("You can only provide `rescue` when `desired_status` is %q.", modelStateActive))
+	}
 }
 
 // ConfigValidators validates the resource configuration
@@ -142,9 +184,148 @@ func (r *serverResource) ConfigValidators(_ context.Context) []resource.ConfigVa
 			path.MatchRoot("image_id"),
 			path.MatchRoot("boot_volume"),
 		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user_data"),
+			path.MatchRoot("user_data_base64"),
+		),
 	}
 }
 
+// ModifyPlan warns if changed user data is not applied to the server and if a server that joins an affinity group
+// can most likely not be scheduled according to the group's policy.
+func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip destroy plans
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateModel *Model
+	if !req.State.Raw.IsNull() {
+		stateModel = &Model{}
+		resp.Diagnostics.Append(req.State.Get(ctx, stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(resp.RequiresReplace) == 0 && userDataChangeIgnored(stateModel, &planModel) {
+			core.LogAndAddWarning(ctx, &resp.Diagnostics, "User data is not applied to the server",
+				"The user data changed, but `user_data_replace_on_change` is `false`, so the server is not replaced. "+
+					"The new user data is only stored in the state and only applies to newly created servers.")
+		}
+	}
+
+	// skip plans without a configured client
+	if r.client == nil {
+		return
+	}
+	if planModel.AffinityGroup.IsNull() || planModel.AffinityGroup.IsUnknown() || planModel.ProjectId.IsUnknown() {
+		return
+	}
+
+	// the server is only placed when it is created, which is also the case when it is replaced
+	var serverId string
+	if stateModel != nil {
+		if len(resp.RequiresReplace) == 0 {
+			return
+		}
+		serverId = stateModel.ServerId.ValueString()
+	}
+
+	projectId := planModel.ProjectId.ValueString()
+	affinityGroupId := planModel.AffinityGroup.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "affinity_group_id", affinityGroupId)
+
+	affinityGroup, err := r.client.GetAffinityGroupExecute(ctx, projectId, affinityGroupId)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to check the placement of the server in the affinity group: %v", err))
+		return
+	}
+	var servers []iaas.Server
+	if affinityGroup.GetPolicy() == affinityGroupPolicyHardAffinity && len(affinityGroup.GetMembers()) > 0 {
+		serversResp, err := r.client.ListServersExecute(ctx, projectId)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Unable to check the placement of the server in the affinity group: %v", err))
+			return
+		}
+		servers = serversResp.GetItems()
+	}
+
+	for _, warning := range affinityGroupPlacementWarnings(affinityGroup, servers, serverId, planModel.AvailabilityZone) {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Server may not be schedulable in affinity group", warning)
+	}
+}
+
+// affinityGroupPlacementWarnings returns the reasons why a server can most likely not be scheduled in the affinity group.
+// serverId is the server that is replaced by the planned one, it is not counted as a member of the group.
+func affinityGroupPlacementWarnings(affinityGroup *iaas.AffinityGroup, servers []iaas.Server, serverId string, availabilityZone types.String) []string {
+	if affinityGroup == nil {
+		return nil
+	}
+
+	members := []string{}
+	for _, member := range affinityGroup.GetMembers() {
+		if member != serverId {
+			members = append(members, member)
+		}
+	}
+
+	warnings := []string{}
+	switch affinityGroup.GetPolicy() {
+	case affinityGroupPolicyHardAntiAffinity:
+		if len(members)+1 > hardAntiAffinityGroupMemberLimit {
+			warnings = append(warnings, fmt.Sprintf("The %s affinity group %q already has %d members, but can place at most %d servers on different hosts. The creation of the server will likely fail.",
+				affinityGroupPolicyHardAntiAffinity, affinityGroup.GetId(), len(members), hardAntiAffinityGroupMemberLimit))
+		}
+	case affinityGroupPolicyHardAffinity:
+		if availabilityZone.IsNull() || availabilityZone.IsUnknown() {
+			break
+		}
+		for i := range servers {
+			server := &servers[i]
+			if !slices.Contains(members, server.GetId()) || server.AvailabilityZone == nil {
+				continue
+			}
+			if server.GetAvailabilityZone() != availabilityZone.ValueString() {
+				warnings = append(warnings, fmt.Sprintf("The members of the %s affinity group %q are placed in availability zone %q, but the server is planned in %q. The creation of the server will likely fail.",
+					affinityGroupPolicyHardAffinity, affinityGroup.GetId(), server.GetAvailabilityZone(), availabilityZone.ValueString()))
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+// userDataChangeIgnored reports if the planned user data differs from the state, but the server is not replaced
+// because user_data_replace_on_change is false, so the running server never receives the new user data
+func userDataChangeIgnored(stateModel, planModel *Model) bool {
+	if planModel.UserDataReplaceOnChange.IsNull() || planModel.UserDataReplaceOnChange.IsUnknown() || planModel.UserDataReplaceOnChange.ValueBool() {
+		return false
+	}
+	return !planModel.UserData.Equal(stateModel.UserData) || !planModel.UserDataBase64.Equal(stateModel.UserDataBase64)
+}
+
+// userDataRequiresReplace recreates the server on user data changes, unless user_data_replace_on_change is false
+func userDataRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var replaceOnChange types.Bool
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("user_data_replace_on_change"), &replaceOnChange)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.RequiresReplace = replaceOnChange.IsNull() || replaceOnChange.IsUnknown() || replaceOnChange.ValueBool()
+		},
+		"Changing the user data requires replacing the server, unless `user_data_replace_on_change` is `false`.",
+		"Changing the user data requires replacing the server, unless `user_data_replace_on_change` is `false`.",
+	)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -230,7 +411,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "The boot volume for the server",
 				Optional:    true,
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					objectplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+							// Changes of the nested attributes are handled by their own plan modifiers
+							resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+						},
+						"Adding or removing the boot volume requires replacing the server.",
+						"Adding or removing the boot volume requires replacing the server.",
+					),
 				},
 				Attributes: map[string]schema.Attribute{
 					"id": schema.StringAttribute{
@@ -255,10 +443,16 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 						},
 					},
 					"size": schema.Int64Attribute{
-						Description: "The size of the boot volume in GB. Must be provided when `source_type` is `image`.",
+						Description: "The size of the boot volume in GB. Must be provided when `source_type` is `image`. Increasing it resizes the boot volume in place, decreasing it requires replacing the server.",
 						Optional:    true,
 						PlanModifiers: []planmodifier.Int64{
-							int64planmodifier.RequiresReplace(),
+							int64planmodifier.RequiresReplaceIf(
+								func(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+									resp.RequiresReplace = bootVolumeShrinks(req.StateValue, req.PlanValue)
+								},
+								"Decreasing the boot volume size requires replacing the server.",
+								"Decreasing the boot volume size requires replacing the server.",
+							),
 						},
 					},
 					"source_type": schema.StringAttribute{
@@ -330,8 +524,10 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 			},
 			"affinity_group": schema.StringAttribute{
-				Description: "The affinity group the server is assigned to.",
-				Optional:    true,
+				Description: fmt.Sprintf("The affinity group the server is assigned to. On creation, a warning is shown if the server can likely not be scheduled according to the group's policy, "+
+					"i.e. if a `%s` group already has %d members or the members of a `%s` group are placed in another availability zone.",
+					affinityGroupPolicyHardAntiAffinity, hardAntiAffinityGroupMemberLimit, affinityGroupPolicyHardAffinity),
+				Optional: true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 					stringvalidator.LengthAtMost(36),
@@ -344,12 +540,31 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"user_data": schema.StringAttribute{
-				Description: "User data that is passed via cloud-init to the server.",
+				Description: fmt.Sprintf("User data that is passed via cloud-init to the server. Cloud-config data, starting with `#cloud-config`, must be valid YAML. The base64 encoded user data must not exceed %d bytes.", validate.MaxUserDataSize),
 				Optional:    true,
+				Validators: []validator.String{
+					validate.UserData(false),
+				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					userDataRequiresReplace(),
+				},
+			},
+			"user_data_base64": schema.StringAttribute{
+				Description: "Base64 encoded user data that is passed via cloud-init to the server. Use it for binary data, e.g. gzip compressed user data rendered by the `stackit_cloudinit_config` data source. The same validation as for `user_data` applies to the decoded data.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UserData(true),
+				},
+				PlanModifiers: []planmodifier.String{
+					userDataRequiresReplace(),
 				},
 			},
+			"user_data_replace_on_change": schema.BoolAttribute{
+				Description: "Whether a change of `user_data` or `user_data_base64` recreates the server. If set to `false`, the change is only stored in the Terraform state: the API cannot update the user data of an existing server, so the new user data is used the next time the server is recreated. A warning is shown when such a change is planned. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 			"created_at": schema.StringAttribute{
 				Description: "Date-time when the server was created",
 				Computed:    true,
@@ -376,6 +591,45 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					desiredStateModifier{},
 				},
 			},
+			"volume_attachments": schema.SetAttribute{
+				Description: "The IDs of volumes which should be attached to the server. Volumes are attached and detached in place. Volumes attached outside of this attribute, e.g. with `stackit_server_volume_attach`, are not affected. Don't manage the same volume with both.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						validate.UUID(),
+						validate.NoSeparator(),
+					),
+				},
+			},
+			"rescue": schema.SingleNestedAttribute{
+				Description: "If set, the server is put into rescue mode, booting from the given rescue image with the original boot volume attached as an additional disk. Removing it returns the server to normal operation. Can only be used if `desired_status` is `active` or unset.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"image_id": schema.StringAttribute{
+						Description: "The ID of the image to boot the server from in rescue mode.",
+						Required:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+							validate.NoSeparator(),
+						},
+					},
+				},
+			},
+			"reboot_trigger": schema.StringAttribute{
+				Description: "Arbitrary value that reboots the server whenever it changes, e.g. a timestamp or a hash of the server configuration. Setting it for the first time or removing it doesn't reboot the server. Can only be changed if `desired_status` is `active` or unset and `rescue` is not set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					rebootTriggerModifier{},
+				},
+			},
+			"reboot_type": schema.StringAttribute{
+				Description: "The type of reboot done when `reboot_trigger` changes. A soft reboot gracefully shuts down the operating system, a hard reboot power cycles the server. " + utils.SupportedValuesDocumentation(rebootTypeOptions) + " Defaults to `" + rebootTypeSoft + "`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(rebootTypeOptions...),
+				},
+			},
 		},
 	}
 }
@@ -417,6 +671,53 @@ func (d desiredStateModifier) PlanModifyString(ctx context.Context, req planmodi
 	}
 }
 
+var _ planmodifier.String = rebootTriggerModifier{}
+
+type rebootTriggerModifier struct {
+}
+
+// Description implements planmodifier.String.
+func (d rebootTriggerModifier) Description(context.Context) string {
+	return "validates that the server can be rebooted"
+}
+
+// MarkdownDescription implements planmodifier.String.
+func (d rebootTriggerModifier) MarkdownDescription(ctx context.Context) string {
+	return d.Description(ctx)
+}
+
+// PlanModifyString implements planmodifier.String.
+func (d rebootTriggerModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) { //nolint: gocritic //signature is defined by terraform api
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !rebootTriggered(req.StateValue, req.PlanValue) {
+		return
+	}
+
+	var (
+		desiredStatus types.String
+		rescue        types.Object
+	)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("desired_status"), &desiredStatus)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rescue"), &rescue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := checkReboot(desiredStatus, rescue); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rebooting server", err.Error())
+	}
+}
+
+// checkReboot returns an error if a server with the planned status and rescue configuration cannot be rebooted
+func checkReboot(desiredStatus types.String, rescue types.Object) error {
+	if !desiredStatus.IsNull() && !desiredStatus.IsUnknown() && desiredStatus.ValueString() != modelStateActive {
+		return fmt.Errorf("`reboot_trigger` can only be changed when `desired_status` is %q, but it is %q", modelStateActive, desiredStatus.ValueString())
+	}
+	if !rescue.IsNull() {
+		return fmt.Errorf("`reboot_trigger` cannot be changed while the server is in rescue mode")
+	}
+	return nil
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
@@ -461,6 +762,9 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("get server details: %v", err))
 	}
 
+	// The server is rescued after it has been created, keep the planned value before mapping
+	plannedRescue := model.Rescue
+
 	// Map response body to schema
 	err = mapFields(ctx, server, &model)
 	if err != nil {
@@ -468,11 +772,25 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Attach the volumes before the server status is changed, a deallocated server cannot attach volumes
+	if err := r.updateVolumeAttachments(ctx, projectId, serverId, types.SetNull(types.StringType), model.VolumeAttachments); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", err.Error())
+		return
+	}
+
 	if err := updateServerStatus(ctx, r.client, server.Status, &model); err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creting server", fmt.Sprintf("update server state: %v", err))
 		return
 	}
 
+	if !plannedRescue.IsNull() {
+		if err := r.rescueServer(ctx, projectId, serverId, plannedRescue); err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", err.Error())
+			return
+		}
+	}
+	model.Rescue = plannedRescue
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -527,6 +845,286 @@ func deallocatServer(ctx context.Context, client serverControlClient, projectId,
 	return nil
 }
 
+// rescueServer puts the server into rescue mode, booting from the image in the rescue object
+func (r *serverResource) rescueServer(ctx context.Context, projectId, serverId string, rescue types.Object) error {
+	var rescueModel = &rescueModel{}
+	diags := rescue.As(ctx, rescueModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return fmt.Errorf("convert rescue object to struct: %w", core.DiagsToError(diags))
+	}
+
+	tflog.Debug(ctx, "rescuing server")
+	payload := iaas.RescueServerPayload{
+		Image: conversion.StringValueToPointer(rescueModel.ImageId),
+	}
+	if err := r.client.RescueServer(ctx, projectId, serverId).RescueServerPayload(payload).Execute(); err != nil {
+		return fmt.Errorf("cannot rescue server: %w", err)
+	}
+	_, err := wait.RescueServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check rescued server: %w", err)
+	}
+	return nil
+}
+
+// unrescueServer returns the server from rescue mode to normal operation
+func (r *serverResource) unrescueServer(ctx context.Context, projectId, serverId string) error {
+	tflog.Debug(ctx, "unrescuing server")
+	if err := r.client.UnrescueServerExecute(ctx, projectId, serverId); err != nil {
+		return fmt.Errorf("cannot unrescue server: %w", err)
+	}
+	_, err := wait.UnrescueServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check unrescued server: %w", err)
+	}
+	return nil
+}
+
+// rebootServer does a soft or hard reboot of the server
+func (r *serverResource) rebootServer(ctx context.Context, projectId, serverId string, rebootType types.String) error {
+	action := rebootTypeSoft
+	if !rebootType.IsNull() && !rebootType.IsUnknown() {
+		action = rebootType.ValueString()
+	}
+
+	// The server returns to the status it had before the reboot
+	server, err := r.client.GetServerExecute(ctx, projectId, serverId)
+	if err != nil {
+		return fmt.Errorf("cannot get server status: %w", err)
+	}
+	if server.Status == nil {
+		return fmt.Errorf("cannot get server status: the status is missing")
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("doing %s reboot of server", action))
+	if err := r.client.RebootServer(ctx, projectId, serverId).Action(action).Execute(); err != nil {
+		return fmt.Errorf("cannot reboot server: %w", err)
+	}
+	_, err = rebootServerWaitHandler(ctx, r.client, projectId, serverId, *server.Status, rebootWaitGracePeriod).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check rebooted server: %w", err)
+	}
+	return nil
+}
+
+// rebootServerWaitHandler waits for the server to return to targetStatus, the status it had before the reboot.
+// The SDK has no dedicated wait handler for reboots and the server is still in targetStatus right after the reboot was requested,
+// so the server is only considered rebooted once it was seen in another status or gracePeriod has passed.
+func rebootServerWaitHandler(ctx context.Context, a wait.APIClientInterface, projectId, serverId, targetStatus string, gracePeriod time.Duration) (h *sdkWait.AsyncActionHandler[iaas.Server]) {
+	start := time.Now()
+	handler := sdkWait.New(func() (waitFinished bool, response *iaas.Server, err error) {
+		server, err := a.GetServerExecute(ctx, projectId, serverId)
+		if err != nil {
+			return false, server, err
+		}
+		if server.Id == nil || server.Status == nil {
+			return false, server, fmt.Errorf("reboot failed for server with id %s, the response is not valid: the id or the status are missing", serverId)
+		}
+		if *server.Status == wait.ErrorStatus {
+			if server.ErrorMessage != nil {
+				return true, server, fmt.Errorf("reboot failed for server with id %s: %s", serverId, *server.ErrorMessage)
+			}
+			return true, server, fmt.Errorf("reboot failed for server with id %s", serverId)
+		}
+		if *server.Status != targetStatus {
+			h.IntermediateStateReached = true
+			return false, server, nil
+		}
+		if h.IntermediateStateReached || time.Since(start) >= gracePeriod {
+			return true, server, nil
+		}
+		return false, server, nil
+	})
+	handler.SetTimeout(20 * time.Minute)
+	return handler
+}
+
+// bootVolumeShrinks reports if the planned boot volume size is smaller than the current one
+func bootVolumeShrinks(stateSize, planSize types.Int64) bool {
+	if stateSize.IsNull() || stateSize.IsUnknown() || planSize.IsNull() || planSize.IsUnknown() {
+		return false
+	}
+	return planSize.ValueInt64() < stateSize.ValueInt64()
+}
+
+// resizeBootVolume grows the boot volume if its planned size is larger than the current one
+func (r *serverResource) resizeBootVolume(ctx context.Context, projectId string, stateModel, model *Model) error {
+	if stateModel.BootVolume.IsNull() || model.BootVolume.IsNull() || model.BootVolume.IsUnknown() {
+		return nil
+	}
+	var stateBootVolume = &bootVolumeModel{}
+	diags := stateModel.BootVolume.As(ctx, stateBootVolume, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return fmt.Errorf("convert boot volume object to struct: %w", core.DiagsToError(diags))
+	}
+	var planBootVolume = &bootVolumeModel{}
+	diags = model.BootVolume.As(ctx, planBootVolume, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return fmt.Errorf("convert boot volume object to struct: %w", core.DiagsToError(diags))
+	}
+
+	if planBootVolume.Size.IsNull() || planBootVolume.Size.IsUnknown() || planBootVolume.Size.ValueInt64() <= stateBootVolume.Size.ValueInt64() {
+		return nil
+	}
+	volumeId := stateBootVolume.Id.ValueString()
+	if volumeId == "" {
+		return fmt.Errorf("resizing the boot volume: boot volume ID not present")
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("resizing boot volume to %d GB", planBootVolume.Size.ValueInt64()))
+	payload := iaas.ResizeVolumePayload{
+		Size: conversion.Int64ValueToPointer(planBootVolume.Size),
+	}
+	err := r.client.ResizeVolume(ctx, projectId, volumeId).ResizeVolumePayload(payload).Execute()
+	if err != nil {
+		return fmt.Errorf("resizing the boot volume, calling API: %w", err)
+	}
+	return nil
+}
+
+// volumeAttachmentsDiff returns the volume IDs which have to be attached and detached to get from the current to the planned attachments
+func volumeAttachmentsDiff(current, planned types.Set) (toAttach, toDetach []string, err error) {
+	currentIds, err := setToStrings(current)
+	if err != nil {
+		return nil, nil, err
+	}
+	plannedIds, err := setToStrings(planned)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currentSet := make(map[string]bool, len(currentIds))
+	for _, id := range currentIds {
+		currentSet[id] = true
+	}
+	plannedSet := make(map[string]bool, len(plannedIds))
+	for _, id := range plannedIds {
+		plannedSet[id] = true
+		if !currentSet[id] {
+			toAttach = append(toAttach, id)
+		}
+	}
+	for _, id := range currentIds {
+		if !plannedSet[id] {
+			toDetach = append(toDetach, id)
+		}
+	}
+	return toAttach, toDetach, nil
+}
+
+func setToStrings(set types.Set) ([]string, error) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+	var result []string
+	for _, element := range set.Elements() {
+		elementString, ok := element.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("type assertion for volume attachments failed")
+		}
+		result = append(result, elementString.ValueString())
+	}
+	return result, nil
+}
+
+// updateVolumeAttachments detaches removed volumes and attaches new volumes to the server
+func (r *serverResource) updateVolumeAttachments(ctx context.Context, projectId, serverId string, current, planned types.Set) error {
+	toAttach, toDetach, err := volumeAttachmentsDiff(current, planned)
+	if err != nil {
+		return err
+	}
+
+	for _, volumeId := range toDetach {
+		tflog.Debug(ctx, fmt.Sprintf("detaching volume %q", volumeId))
+		err := r.client.RemoveVolumeFromServer(ctx, projectId, serverId, volumeId).Execute()
+		if err != nil {
+			return fmt.Errorf("detaching volume %q, calling API: %w", volumeId, err)
+		}
+		_, err = wait.RemoveVolumeFromServerWaitHandler(ctx, r.client, projectId, serverId, volumeId).WaitWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("volume %q detachment waiting: %w", volumeId, err)
+		}
+	}
+
+	for _, volumeId := range toAttach {
+		tflog.Debug(ctx, fmt.Sprintf("attaching volume %q", volumeId))
+		payload := iaas.AddVolumeToServerPayload{
+			DeleteOnTermination: sdkUtils.Ptr(false),
+		}
+		_, err := r.client.AddVolumeToServer(ctx, projectId, serverId, volumeId).AddVolumeToServerPayload(payload).Execute()
+		if err != nil {
+			return fmt.Errorf("attaching volume %q, calling API: %w", volumeId, err)
+		}
+		_, err = wait.AddVolumeToServerWaitHandler(ctx, r.client, projectId, serverId, volumeId).WaitWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("volume %q attachment waiting: %w", volumeId, err)
+		}
+	}
+	return nil
+}
+
+// mapVolumeAttachments keeps the managed volume attachments which are still attached to the server.
+// Volumes attached by other means are ignored.
+func mapVolumeAttachments(ctx context.Context, attachedVolumes *iaas.VolumeAttachmentListResponse, model *Model) error {
+	if attachedVolumes == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	attached := map[string]bool{}
+	if attachedVolumes.Items != nil {
+		for _, attachment := range *attachedVolumes.Items {
+			if attachment.VolumeId != nil {
+				attached[*attachment.VolumeId] = true
+			}
+		}
+	}
+
+	modelIds, err := setToStrings(model.VolumeAttachments)
+	if err != nil {
+		return err
+	}
+	volumeIds := []string{}
+	for _, id := range modelIds {
+		if attached[id] {
+			volumeIds = append(volumeIds, id)
+		}
+	}
+
+	volumeAttachments, diags := types.SetValueFrom(ctx, types.StringType, volumeIds)
+	if diags.HasError() {
+		return fmt.Errorf("failed to map volume attachments: %w", core.DiagsToError(diags))
+	}
+	model.VolumeAttachments = volumeAttachments
+	return nil
+}
+
+// rescueChanged reports if the server has to leave or enter rescue mode to reach the planned rescue configuration
+func rescueChanged(currentStatus *string, stateRescue, planRescue types.Object) (unrescue, rescue bool) {
+	rescued := currentStatus != nil && *currentStatus == wait.ServerRescueStatus
+	switch {
+	case planRescue.IsNull():
+		return rescued, false
+	case !rescued:
+		return false, true
+	case !planRescue.Equal(stateRescue):
+		// The rescue image changed, the server must leave rescue mode before it can be rescued again
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// rebootTriggered reports if the reboot trigger changed to a new value
+func rebootTriggered(stateTrigger, planTrigger types.String) bool {
+	if stateTrigger.IsNull() || planTrigger.IsNull() || planTrigger.IsUnknown() {
+		return false
+	}
+	return !planTrigger.Equal(stateTrigger)
+}
+
 // updateServerStatus applies the appropriate server state changes for the actual current and the intended state
 func updateServerStatus(ctx context.Context, client serverControlClient, currentState *string, model *Model) error {
 	if currentState == nil {
@@ -606,6 +1204,11 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "server_id", serverId)
 
+	// Servers created before user_data_replace_on_change was introduced have no value in the state
+	if model.UserDataReplaceOnChange.IsNull() {
+		model.UserDataReplaceOnChange = types.BoolValue(true)
+	}
+
 	serverReq := r.client.GetServer(ctx, projectId, serverId)
 	serverReq = serverReq.Details(true)
 	serverResp, err := serverReq.Execute()
@@ -625,6 +1228,20 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+
+	// Only look up the attached volumes if they are managed by this resource
+	if !model.VolumeAttachments.IsNull() {
+		attachedVolumes, err := r.client.ListAttachedVolumesExecute(ctx, projectId, serverId)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Listing attached volumes: %v", err))
+			return
+		}
+		err = mapVolumeAttachments(ctx, attachedVolumes, &model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading server", fmt.Sprintf("Processing attached volumes: %v", err))
+			return
+		}
+	}
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -671,6 +1288,17 @@ func (r *serverResource) updateServerAttributes(ctx context.Context, model, stat
 	return updatedServer, nil
 }
 
+// updateServerVolumes resizes the boot volume and attaches and detaches volumes
+func (r *serverResource) updateServerVolumes(ctx context.Context, model, stateModel *Model) error {
+	projectId := model.ProjectId.ValueString()
+	serverId := model.ServerId.ValueString()
+
+	if err := r.resizeBootVolume(ctx, projectId, stateModel, model); err != nil {
+		return err
+	}
+	return r.updateVolumeAttachments(ctx, projectId, serverId, stateModel.VolumeAttachments, model.VolumeAttachments)
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
@@ -699,6 +1327,17 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	)
 	if server, err = r.client.GetServer(ctx, model.ProjectId.ValueString(), model.ServerId.ValueString()).Execute(); err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error retrieving server state", fmt.Sprintf("Getting server state: %v", err))
+		return
+	}
+
+	// A server in rescue mode cannot change its status, so leave rescue mode first
+	unrescue, rescue := rescueChanged(server.Status, stateModel.Rescue, model.Rescue)
+	if unrescue {
+		if err := r.unrescueServer(ctx, projectId, serverId); err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
+			return
+		}
+		server.Status = sdkUtils.Ptr(wait.ServerActiveStatus)
 	}
 
 	if model.DesiredStatus.ValueString() == modelStateDeallocated {
@@ -710,6 +1349,12 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 			return
 		}
 
+		err = r.updateServerVolumes(ctx, &model, &stateModel)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
+			return
+		}
+
 		if err := updateServerStatus(ctx, r.client, server.Status, &model); err != nil {
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
 			return
@@ -726,6 +1371,26 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
 			return
 		}
+
+		err = r.updateServerVolumes(ctx, &model, &stateModel)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
+			return
+		}
+	}
+
+	if rebootTriggered(stateModel.RebootTrigger, model.RebootTrigger) {
+		if err := r.rebootServer(ctx, projectId, serverId, model.RebootType); err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
+			return
+		}
+	}
+
+	if rescue {
+		if err := r.rescueServer(ctx, projectId, serverId, model.Rescue); err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating server", err.Error())
+			return
+		}
 	}
 
 	// Re-fetch the server data, to get the details values.
@@ -801,6 +1466,7 @@ func (r *serverResource) ImportState(ctx context.Context, req resource.ImportSta
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_data_replace_on_change"), true)...)
 	tflog.Info(ctx, "server state imported")
 }
 
@@ -925,7 +1591,10 @@ func mapFields(ctx context.Context, serverResp *iaas.Server, model *Model) error
 		model.AvailabilityZone = types.StringPointerValue(serverResp.AvailabilityZone)
 	}
 
-	if serverResp.UserData != nil && len(*serverResp.UserData) > 0 {
+	// Base64 encoded user data is kept as configured. The same applies to user data that is not replaced on change,
+	// as the API cannot update it and the configured value would otherwise never converge with the API value.
+	keepUserData := !model.UserData.IsNull() && !model.UserDataReplaceOnChange.IsNull() && !model.UserDataReplaceOnChange.ValueBool()
+	if serverResp.UserData != nil && len(*serverResp.UserData) > 0 && model.UserDataBase64.IsNull() && !keepUserData {
 		model.UserData = types.StringValue(string(*serverResp.UserData))
 	}
 	model.Name = types.StringPointerValue(serverResp.Name)
@@ -937,6 +1606,11 @@ func mapFields(ctx context.Context, serverResp *iaas.Server, model *Model) error
 	model.UpdatedAt = updatedAt
 	model.LaunchedAt = launchedAt
 
+	// The rescue image is not returned by the API, so the configured value is kept while the server is in rescue mode
+	if serverResp.Status == nil || *serverResp.Status != wait.ServerRescueStatus {
+		model.Rescue = types.ObjectNull(rescueTypes)
+	}
+
 	return nil
 }
 
@@ -980,6 +1654,10 @@ func toCreatePayload(ctx context.Context, model *Model) (*iaas.CreateServerPaylo
 		encodedUserData := make([]byte, base64.StdEncoding.EncodedLen(len(src)))
 		base64.StdEncoding.Encode(encodedUserData, src)
 		userData = &encodedUserData
+	} else if !model.UserDataBase64.IsNull() && !model.UserDataBase64.IsUnknown() {
+		// The user data is already base64 encoded
+		encodedUserData := []byte(model.UserDataBase64.ValueString())
+		userData = &encodedUserData
 	}
 
 	var network *iaas.CreateServerPayloadNetworking
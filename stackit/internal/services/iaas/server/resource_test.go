@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -36,8 +37,9 @@ func TestMapFields(t *testing.T) {
 		{
 			"default_values",
 			Model{
-				ProjectId: types.StringValue("pid"),
-				ServerId:  types.StringValue("sid"),
+				ProjectId:         types.StringValue("pid"),
+				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
 			},
 			&iaas.Server{
 				Id: utils.Ptr("sid"),
@@ -46,6 +48,7 @@ func TestMapFields(t *testing.T) {
 				Id:                types.StringValue("pid,sid"),
 				ProjectId:         types.StringValue("pid"),
 				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
 				Name:              types.StringNull(),
 				AvailabilityZone:  types.StringNull(),
 				Labels:            types.MapNull(types.StringType),
@@ -63,8 +66,9 @@ func TestMapFields(t *testing.T) {
 		{
 			"simple_values",
 			Model{
-				ProjectId: types.StringValue("pid"),
-				ServerId:  types.StringValue("sid"),
+				ProjectId:         types.StringValue("pid"),
+				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
 			},
 			&iaas.Server{
 				Id:               utils.Ptr("sid"),
@@ -90,11 +94,12 @@ func TestMapFields(t *testing.T) {
 				Status:        utils.Ptr("active"),
 			},
 			Model{
-				Id:               types.StringValue("pid,sid"),
-				ProjectId:        types.StringValue("pid"),
-				ServerId:         types.StringValue("sid"),
-				Name:             types.StringValue("name"),
-				AvailabilityZone: types.StringValue("zone"),
+				Id:                types.StringValue("pid,sid"),
+				ProjectId:         types.StringValue("pid"),
+				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
+				Name:              types.StringValue("name"),
+				AvailabilityZone:  types.StringValue("zone"),
 				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
 					"key": types.StringValue("value"),
 				}),
@@ -111,9 +116,10 @@ func TestMapFields(t *testing.T) {
 		{
 			"empty_labels",
 			Model{
-				ProjectId: types.StringValue("pid"),
-				ServerId:  types.StringValue("sid"),
-				Labels:    types.MapValueMust(types.StringType, map[string]attr.Value{}),
+				ProjectId:         types.StringValue("pid"),
+				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
+				Labels:            types.MapValueMust(types.StringType, map[string]attr.Value{}),
 			},
 			&iaas.Server{
 				Id: utils.Ptr("sid"),
@@ -122,6 +128,7 @@ func TestMapFields(t *testing.T) {
 				Id:                types.StringValue("pid,sid"),
 				ProjectId:         types.StringValue("pid"),
 				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
 				Name:              types.StringNull(),
 				AvailabilityZone:  types.StringNull(),
 				Labels:            types.MapValueMust(types.StringType, map[string]attr.Value{}),
@@ -136,6 +143,110 @@ func TestMapFields(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"rescued_server_keeps_rescue_image",
+			Model{
+				ProjectId:         types.StringValue("pid"),
+				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
+				Rescue: types.ObjectValueMust(rescueTypes, map[string]attr.Value{
+					"image_id": types.StringValue("rescue_image_id"),
+				}),
+			},
+			&iaas.Server{
+				Id:     utils.Ptr("sid"),
+				Status: utils.Ptr(wait.ServerRescueStatus),
+			},
+			Model{
+				Id:                types.StringValue("pid,sid"),
+				ProjectId:         types.StringValue("pid"),
+				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
+				Name:              types.StringNull(),
+				AvailabilityZone:  types.StringNull(),
+				Labels:            types.MapNull(types.StringType),
+				ImageId:           types.StringNull(),
+				NetworkInterfaces: types.ListNull(types.StringType),
+				KeypairName:       types.StringNull(),
+				AffinityGroup:     types.StringNull(),
+				UserData:          types.StringNull(),
+				CreatedAt:         types.StringNull(),
+				UpdatedAt:         types.StringNull(),
+				LaunchedAt:        types.StringNull(),
+				Rescue: types.ObjectValueMust(rescueTypes, map[string]attr.Value{
+					"image_id": types.StringValue("rescue_image_id"),
+				}),
+			},
+			true,
+		},
+		{
+			"unrescued_server_drops_rescue_image",
+			Model{
+				ProjectId:         types.StringValue("pid"),
+				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
+				Rescue: types.ObjectValueMust(rescueTypes, map[string]attr.Value{
+					"image_id": types.StringValue("rescue_image_id"),
+				}),
+			},
+			&iaas.Server{
+				Id:     utils.Ptr("sid"),
+				Status: utils.Ptr(wait.ServerActiveStatus),
+			},
+			Model{
+				Id:                types.StringValue("pid,sid"),
+				ProjectId:         types.StringValue("pid"),
+				ServerId:          types.StringValue("sid"),
+				VolumeAttachments: types.SetNull(types.StringType),
+				Name:              types.StringNull(),
+				AvailabilityZone:  types.StringNull(),
+				Labels:            types.MapNull(types.StringType),
+				ImageId:           types.StringNull(),
+				NetworkInterfaces: types.ListNull(types.StringType),
+				KeypairName:       types.StringNull(),
+				AffinityGroup:     types.StringNull(),
+				UserData:          types.StringNull(),
+				CreatedAt:         types.StringNull(),
+				UpdatedAt:         types.StringNull(),
+				LaunchedAt:        types.StringNull(),
+				Rescue:            types.ObjectNull(rescueTypes),
+			},
+			true,
+		},
+		{
+			"user_data_kept_if_not_replaced_on_change",
+			Model{
+				ProjectId:               types.StringValue("pid"),
+				ServerId:                types.StringValue("sid"),
+				VolumeAttachments:       types.SetNull(types.StringType),
+				UserData:                types.StringValue("new_user_data"),
+				UserDataReplaceOnChange: types.BoolValue(false),
+			},
+			&iaas.Server{
+				Id:       utils.Ptr("sid"),
+				UserData: utils.Ptr([]byte("old_user_data")),
+			},
+			Model{
+				Id:                      types.StringValue("pid,sid"),
+				ProjectId:               types.StringValue("pid"),
+				ServerId:                types.StringValue("sid"),
+				VolumeAttachments:       types.SetNull(types.StringType),
+				Name:                    types.StringNull(),
+				AvailabilityZone:        types.StringNull(),
+				Labels:                  types.MapNull(types.StringType),
+				ImageId:                 types.StringNull(),
+				NetworkInterfaces:       types.ListNull(types.StringType),
+				KeypairName:             types.StringNull(),
+				AffinityGroup:           types.StringNull(),
+				UserData:                types.StringValue("new_user_data"),
+				UserDataReplaceOnChange: types.BoolValue(false),
+				CreatedAt:               types.StringNull(),
+				UpdatedAt:               types.StringNull(),
+				LaunchedAt:              types.StringNull(),
+				Rescue:                  types.ObjectNull(rescueTypes),
+			},
+			true,
+		},
 		{
 			"response_nil_fail",
 			Model{},
@@ -264,6 +375,24 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"base64 encoded user data",
+			&Model{
+				Name:           types.StringValue("name"),
+				Labels:         types.MapNull(types.StringType),
+				ImageId:        types.StringValue("image"),
+				MachineType:    types.StringValue("machine_type"),
+				UserDataBase64: types.StringValue(base64EncodedUserData),
+			},
+			&iaas.CreateServerPayload{
+				Name:        utils.Ptr("name"),
+				Labels:      &map[string]interface{}{},
+				ImageId:     utils.Ptr("image"),
+				MachineType: utils.Ptr("machine_type"),
+				UserData:    utils.Ptr([]byte(base64EncodedUserData)),
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
@@ -327,6 +456,438 @@ func TestToUpdatePayload(t *testing.T) {
 	}
 }
 
+func TestVolumeAttachmentsDiff(t *testing.T) {
+	tests := []struct {
+		description      string
+		current          types.Set
+		planned          types.Set
+		expectedToAttach []string
+		expectedToDetach []string
+	}{
+		{
+			"create",
+			types.SetNull(types.StringType),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("vid1"),
+				types.StringValue("vid2"),
+			}),
+			[]string{"vid1", "vid2"},
+			nil,
+		},
+		{
+			"attach_and_detach",
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("vid1"),
+				types.StringValue("vid2"),
+			}),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("vid2"),
+				types.StringValue("vid3"),
+			}),
+			[]string{"vid3"},
+			[]string{"vid1"},
+		},
+		{
+			"remove_all",
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("vid1"),
+			}),
+			types.SetNull(types.StringType),
+			nil,
+			[]string{"vid1"},
+		},
+		{
+			"unchanged",
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("vid1"),
+			}),
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("vid1"),
+			}),
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			toAttach, toDetach, err := volumeAttachmentsDiff(tt.current, tt.planned)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(toAttach, tt.expectedToAttach)
+			if diff != "" {
+				t.Fatalf("Volumes to attach do not match: %s", diff)
+			}
+			diff = cmp.Diff(toDetach, tt.expectedToDetach)
+			if diff != "" {
+				t.Fatalf("Volumes to detach do not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapVolumeAttachments(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaas.VolumeAttachmentListResponse
+		expected    types.Set
+		isValid     bool
+	}{
+		{
+			"ignores_unmanaged_volumes",
+			Model{
+				VolumeAttachments: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("vid1"),
+				}),
+			},
+			&iaas.VolumeAttachmentListResponse{
+				Items: &[]iaas.VolumeAttachment{
+					{VolumeId: utils.Ptr("boot_volume_id")},
+					{VolumeId: utils.Ptr("vid1")},
+					{VolumeId: utils.Ptr("other_vid")},
+				},
+			},
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("vid1"),
+			}),
+			true,
+		},
+		{
+			"detached_volume_removed",
+			Model{
+				VolumeAttachments: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("vid1"),
+					types.StringValue("vid2"),
+				}),
+			},
+			&iaas.VolumeAttachmentListResponse{
+				Items: &[]iaas.VolumeAttachment{
+					{VolumeId: utils.Ptr("vid2")},
+				},
+			},
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("vid2"),
+			}),
+			true,
+		},
+		{
+			"no_attached_volumes",
+			Model{
+				VolumeAttachments: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("vid1"),
+				}),
+			},
+			&iaas.VolumeAttachmentListResponse{
+				Items: &[]iaas.VolumeAttachment{},
+			},
+			types.SetValueMust(types.StringType, []attr.Value{}),
+			true,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			types.SetNull(types.StringType),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapVolumeAttachments(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state.VolumeAttachments, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBootVolumeShrinks(t *testing.T) {
+	tests := []struct {
+		description string
+		stateSize   types.Int64
+		planSize    types.Int64
+		expected    bool
+	}{
+		{"grow", types.Int64Value(10), types.Int64Value(20), false},
+		{"shrink", types.Int64Value(20), types.Int64Value(10), true},
+		{"unchanged", types.Int64Value(10), types.Int64Value(10), false},
+		{"no_state", types.Int64Null(), types.Int64Value(10), false},
+		{"unknown_plan", types.Int64Value(10), types.Int64Unknown(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := bootVolumeShrinks(tt.stateSize, tt.planSize)
+			if output != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestRescueChanged(t *testing.T) {
+	rescueImage := types.ObjectValueMust(rescueTypes, map[string]attr.Value{
+		"image_id": types.StringValue("image_id"),
+	})
+	otherRescueImage := types.ObjectValueMust(rescueTypes, map[string]attr.Value{
+		"image_id": types.StringValue("other_image_id"),
+	})
+	tests := []struct {
+		description      string
+		currentStatus    *string
+		stateRescue      types.Object
+		planRescue       types.Object
+		expectedUnrescue bool
+		expectedRescue   bool
+	}{
+		{
+			"not_rescued",
+			utils.Ptr(wait.ServerActiveStatus),
+			types.ObjectNull(rescueTypes),
+			types.ObjectNull(rescueTypes),
+			false,
+			false,
+		},
+		{
+			"enter_rescue",
+			utils.Ptr(wait.ServerActiveStatus),
+			types.ObjectNull(rescueTypes),
+			rescueImage,
+			false,
+			true,
+		},
+		{
+			"leave_rescue",
+			utils.Ptr(wait.ServerRescueStatus),
+			rescueImage,
+			types.ObjectNull(rescueTypes),
+			true,
+			false,
+		},
+		{
+			"stay_rescued",
+			utils.Ptr(wait.ServerRescueStatus),
+			rescueImage,
+			rescueImage,
+			false,
+			false,
+		},
+		{
+			"change_rescue_image",
+			utils.Ptr(wait.ServerRescueStatus),
+			rescueImage,
+			otherRescueImage,
+			true,
+			true,
+		},
+		{
+			"rescue_left_outside_of_terraform",
+			utils.Ptr(wait.ServerActiveStatus),
+			rescueImage,
+			rescueImage,
+			false,
+			true,
+		},
+		{
+			"unknown_status",
+			nil,
+			types.ObjectNull(rescueTypes),
+			rescueImage,
+			false,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			unrescue, rescue := rescueChanged(tt.currentStatus, tt.stateRescue, tt.planRescue)
+			if unrescue != tt.expectedUnrescue {
+				t.Errorf("expected unrescue %t, got %t", tt.expectedUnrescue, unrescue)
+			}
+			if rescue != tt.expectedRescue {
+				t.Errorf("expected rescue %t, got %t", tt.expectedRescue, rescue)
+			}
+		})
+	}
+}
+
+func TestAffinityGroupPlacementWarnings(t *testing.T) {
+	fullMembers := make([]string, hardAntiAffinityGroupMemberLimit)
+	for i := range fullMembers {
+		fullMembers[i] = fmt.Sprintf("sid%d", i)
+	}
+	servers := []iaas.Server{
+		{
+			Id:               utils.Ptr("sid0"),
+			AvailabilityZone: utils.Ptr("eu01-1"),
+		},
+		{
+			Id:               utils.Ptr("other"),
+			AvailabilityZone: utils.Ptr("eu01-2"),
+		},
+	}
+
+	tests := []struct {
+		description      string
+		affinityGroup    *iaas.AffinityGroup
+		serverId         string
+		availabilityZone types.String
+		expectedWarnings int
+	}{
+		{
+			"nil_affinity_group",
+			nil,
+			"",
+			types.StringNull(),
+			0,
+		},
+		{
+			"hard_anti_affinity_below_limit",
+			&iaas.AffinityGroup{
+				Policy:  utils.Ptr(affinityGroupPolicyHardAntiAffinity),
+				Members: utils.Ptr(fullMembers[1:]),
+			},
+			"",
+			types.StringNull(),
+			0,
+		},
+		{
+			"hard_anti_affinity_limit_exceeded",
+			&iaas.AffinityGroup{
+				Policy:  utils.Ptr(affinityGroupPolicyHardAntiAffinity),
+				Members: utils.Ptr(fullMembers),
+			},
+			"",
+			types.StringNull(),
+			1,
+		},
+		{
+			"hard_anti_affinity_replaced_server_not_counted",
+			&iaas.AffinityGroup{
+				Policy:  utils.Ptr(affinityGroupPolicyHardAntiAffinity),
+				Members: utils.Ptr(fullMembers),
+			},
+			"sid0",
+			types.StringNull(),
+			0,
+		},
+		{
+			"soft_anti_affinity_limit_not_checked",
+			&iaas.AffinityGroup{
+				Policy:  utils.Ptr("soft-anti-affinity"),
+				Members: utils.Ptr(fullMembers),
+			},
+			"",
+			types.StringNull(),
+			0,
+		},
+		{
+			"hard_affinity_same_availability_zone",
+			&iaas.AffinityGroup{
+				Policy:  utils.Ptr(affinityGroupPolicyHardAffinity),
+				Members: &[]string{"sid0"},
+			},
+			"",
+			types.StringValue("eu01-1"),
+			0,
+		},
+		{
+			"hard_affinity_other_availability_zone",
+			&iaas.AffinityGroup{
+				Policy:  utils.Ptr(affinityGroupPolicyHardAffinity),
+				Members: &[]string{"sid0"},
+			},
+			"",
+			types.StringValue("eu01-2"),
+			1,
+		},
+		{
+			"hard_affinity_unknown_availability_zone",
+			&iaas.AffinityGroup{
+				Policy:  utils.Ptr(affinityGroupPolicyHardAffinity),
+				Members: &[]string{"sid0"},
+			},
+			"",
+			types.StringUnknown(),
+			0,
+		},
+		{
+			"hard_affinity_replaced_server_not_counted",
+			&iaas.AffinityGroup{
+				Policy:  utils.Ptr(affinityGroupPolicyHardAffinity),
+				Members: &[]string{"sid0"},
+			},
+			"sid0",
+			types.StringValue("eu01-2"),
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			warnings := affinityGroupPlacementWarnings(tt.affinityGroup, servers, tt.serverId, tt.availabilityZone)
+			if len(warnings) != tt.expectedWarnings {
+				t.Fatalf("Expected %d warnings, got %d: %v", tt.expectedWarnings, len(warnings), warnings)
+			}
+		})
+	}
+}
+
+func TestRebootTriggered(t *testing.T) {
+	tests := []struct {
+		description  string
+		stateTrigger types.String
+		planTrigger  types.String
+		expected     bool
+	}{
+		{
+			"changed",
+			types.StringValue("1"),
+			types.StringValue("2"),
+			true,
+		},
+		{
+			"unchanged",
+			types.StringValue("1"),
+			types.StringValue("1"),
+			false,
+		},
+		{
+			"first_set",
+			types.StringNull(),
+			types.StringValue("1"),
+			false,
+		},
+		{
+			"removed",
+			types.StringValue("1"),
+			types.StringNull(),
+			false,
+		},
+		{
+			"unknown",
+			types.StringValue("1"),
+			types.StringUnknown(),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := rebootTriggered(tt.stateTrigger, tt.planTrigger)
+			if output != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
+
 var _ serverControlClient = (*mockServerControlClient)(nil)
 
 // mockServerControlClient mocks the [serverControlClient] interface with
@@ -589,3 +1150,207 @@ func Test_serverResource_updateServerStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestRebootServerWaitHandler(t *testing.T) {
+	serverId := "serverId"
+	tests := []struct {
+		description  string
+		targetStatus string
+		statuses     []string
+		gracePeriod  time.Duration
+		isValid      bool
+		// getServerCount is only checked if it is set, the number of calls during the grace period depends on the timing
+		getServerCount int
+	}{
+		{
+			"rebooted",
+			wait.ServerActiveStatus,
+			[]string{wait.ServerActiveStatus, "REBOOT", "REBOOT", wait.ServerActiveStatus},
+			time.Hour,
+			true,
+			4,
+		},
+		{
+			"reboot_already_started",
+			wait.ServerActiveStatus,
+			[]string{"HARD_REBOOT", wait.ServerActiveStatus},
+			time.Hour,
+			true,
+			2,
+		},
+		{
+			"fast_reboot_not_seen",
+			wait.ServerActiveStatus,
+			[]string{wait.ServerActiveStatus},
+			10 * time.Millisecond,
+			true,
+			0,
+		},
+		{
+			"rebooted_in_rescue_mode",
+			wait.ServerRescueStatus,
+			[]string{wait.ServerRescueStatus, "REBOOT", wait.ServerRescueStatus},
+			time.Hour,
+			true,
+			3,
+		},
+		{
+			"stopped_server_stays_inactive",
+			wait.ServerInactiveStatus,
+			[]string{wait.ServerInactiveStatus},
+			10 * time.Millisecond,
+			true,
+			0,
+		},
+		{
+			"reboot_failed",
+			wait.ServerActiveStatus,
+			[]string{wait.ServerActiveStatus, "REBOOT", wait.ErrorStatus},
+			time.Hour,
+			false,
+			3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &mockServerControlClient{
+				getServerExecute: func(no int, _ context.Context, _, _ string) (*iaas.Server, error) {
+					status := tt.statuses[len(tt.statuses)-1]
+					if no <= len(tt.statuses) {
+						status = tt.statuses[no-1]
+					}
+					return &iaas.Server{
+						Id:     utils.Ptr(serverId),
+						Status: utils.Ptr(status),
+					}, nil
+				},
+			}
+			handler := rebootServerWaitHandler(context.Background(), client, "projectId", serverId, tt.targetStatus, tt.gracePeriod)
+			_, err := handler.SetThrottle(time.Millisecond).SetTimeout(time.Second).WaitWithContext(context.Background())
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.getServerCount > 0 && client.getServerCalled != tt.getServerCount {
+				t.Fatalf("Wrong number of get server calls: expected %d, got %d", tt.getServerCount, client.getServerCalled)
+			}
+		})
+	}
+}
+
+func TestCheckReboot(t *testing.T) {
+	rescue := types.ObjectValueMust(
+		rescueTypes,
+		map[string]attr.Value{"image_id": types.StringValue("image")},
+	)
+	tests := []struct {
+		description   string
+		desiredStatus types.String
+		rescue        types.Object
+		isValid       bool
+	}{
+		{
+			"default",
+			types.StringNull(),
+			types.ObjectNull(rescueTypes),
+			true,
+		},
+		{
+			"active",
+			types.StringValue(modelStateActive),
+			types.ObjectNull(rescueTypes),
+			true,
+		},
+		{
+			"unknown_status",
+			types.StringUnknown(),
+			types.ObjectNull(rescueTypes),
+			true,
+		},
+		{
+			"inactive",
+			types.StringValue(modelStateInactive),
+			types.ObjectNull(rescueTypes),
+			false,
+		},
+		{
+			"deallocated",
+			types.StringValue(modelStateDeallocated),
+			types.ObjectNull(rescueTypes),
+			false,
+		},
+		{
+			"rescue",
+			types.StringValue(modelStateActive),
+			rescue,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := checkReboot(tt.desiredStatus, tt.rescue)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserDataChangeIgnored(t *testing.T) {
+	tests := []struct {
+		description string
+		state       Model
+		plan        Model
+		expected    bool
+	}{
+		{
+			"user_data_changed_not_replaced",
+			Model{UserData: types.StringValue("old"), UserDataBase64: types.StringNull()},
+			Model{UserData: types.StringValue("new"), UserDataBase64: types.StringNull(), UserDataReplaceOnChange: types.BoolValue(false)},
+			true,
+		},
+		{
+			"user_data_base64_changed_not_replaced",
+			Model{UserData: types.StringNull(), UserDataBase64: types.StringValue("b2xk")},
+			Model{UserData: types.StringNull(), UserDataBase64: types.StringValue("bmV3"), UserDataReplaceOnChange: types.BoolValue(false)},
+			true,
+		},
+		{
+			"user_data_removed_not_replaced",
+			Model{UserData: types.StringValue("old"), UserDataBase64: types.StringNull()},
+			Model{UserData: types.StringNull(), UserDataBase64: types.StringNull(), UserDataReplaceOnChange: types.BoolValue(false)},
+			true,
+		},
+		{
+			"user_data_unchanged",
+			Model{UserData: types.StringValue("old"), UserDataBase64: types.StringNull()},
+			Model{UserData: types.StringValue("old"), UserDataBase64: types.StringNull(), UserDataReplaceOnChange: types.BoolValue(false)},
+			false,
+		},
+		{
+			"user_data_changed_replaced",
+			Model{UserData: types.StringValue("old"), UserDataBase64: types.StringNull()},
+			Model{UserData: types.StringValue("new"), UserDataBase64: types.StringNull(), UserDataReplaceOnChange: types.BoolValue(true)},
+			false,
+		},
+		{
+			"user_data_changed_replace_on_change_default",
+			Model{UserData: types.StringValue("old"), UserDataBase64: types.StringNull()},
+			Model{UserData: types.StringValue("new"), UserDataBase64: types.StringNull(), UserDataReplaceOnChange: types.BoolNull()},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := userDataChangeIgnored(&tt.state, &tt.plan)
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
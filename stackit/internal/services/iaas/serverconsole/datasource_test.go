@@ -0,0 +1,95 @@
+package serverconsole
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		consoleResp *iaas.ServerConsoleUrl
+		logResp     *iaas.GetServerLog200Response
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				ServerId:  types.StringValue("sid"),
+			},
+			&iaas.ServerConsoleUrl{},
+			&iaas.GetServerLog200Response{},
+			DataSourceModel{
+				Id:         types.StringValue("pid,sid"),
+				ProjectId:  types.StringValue("pid"),
+				ServerId:   types.StringValue("sid"),
+				ConsoleUrl: types.StringNull(),
+				ConsoleLog: types.StringNull(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				ServerId:  types.StringValue("sid"),
+				LogLines:  types.Int64Value(2),
+			},
+			&iaas.ServerConsoleUrl{
+				Url: utils.Ptr("https://console.example.com/sid"),
+			},
+			&iaas.GetServerLog200Response{
+				Output: utils.Ptr("line 1\nline 2\n"),
+			},
+			DataSourceModel{
+				Id:         types.StringValue("pid,sid"),
+				ProjectId:  types.StringValue("pid"),
+				ServerId:   types.StringValue("sid"),
+				LogLines:   types.Int64Value(2),
+				ConsoleUrl: types.StringValue("https://console.example.com/sid"),
+				ConsoleLog: types.StringValue("line 1\nline 2\n"),
+			},
+			true,
+		},
+		{
+			"console_response_nil_fail",
+			DataSourceModel{},
+			nil,
+			&iaas.GetServerLog200Response{},
+			DataSourceModel{},
+			false,
+		},
+		{
+			"log_response_nil_fail",
+			DataSourceModel{},
+			&iaas.ServerConsoleUrl{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(tt.consoleResp, tt.logResp, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
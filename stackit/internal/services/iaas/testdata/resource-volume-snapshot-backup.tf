@@ -0,0 +1,32 @@
+variable "project_id" {}
+variable "availability_zone" {}
+variable "size" {}
+variable "name" {}
+variable "label" {}
+
+resource "stackit_volume" "volume" {
+  project_id        = var.project_id
+  availability_zone = var.availability_zone
+  size              = var.size
+}
+
+resource "stackit_volume_snapshot" "snapshot" {
+  project_id = var.project_id
+  volume_id  = stackit_volume.volume.volume_id
+  name       = var.name
+  labels = {
+    "acc-test" : var.label
+  }
+}
+
+resource "stackit_volume_backup" "backup" {
+  project_id = var.project_id
+  name       = var.name
+  source = {
+    type = "snapshot"
+    id   = stackit_volume_snapshot.snapshot.snapshot_id
+  }
+  labels = {
+    "acc-test" : var.label
+  }
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+// AreaNetwork is a network of a project in a network area.
+type AreaNetwork struct {
+	ProjectId string
+	NetworkId string
+	Prefixes  []netip.Prefix
+}
+
+// AreaPrefixes holds the IPv4 address space of a network area and the parts of it which are in use.
+type AreaPrefixes struct {
+	Ranges          []netip.Prefix
+	TransferNetwork *netip.Prefix
+	MinPrefixLength *int64
+	MaxPrefixLength *int64
+	Networks        []AreaNetwork
+}
+
+// GetAreaPrefixes reads the network ranges of a network area and the prefixes of the networks in all of its projects.
+func GetAreaPrefixes(ctx context.Context, client *iaas.APIClient, organizationId, areaId string) (*AreaPrefixes, error) {
+	area, err := client.GetNetworkAreaExecute(ctx, organizationId, areaId)
+	if err != nil {
+		return nil, fmt.Errorf("reading network area: %w", err)
+	}
+	areaPrefixes := &AreaPrefixes{}
+	if ipv4 := area.Ipv4; ipv4 != nil {
+		for _, networkRange := range ipv4.GetNetworkRanges() {
+			prefix, err := netip.ParsePrefix(networkRange.GetPrefix())
+			if err != nil {
+				return nil, fmt.Errorf("parsing network range %q: %w", networkRange.GetPrefix(), err)
+			}
+			areaPrefixes.Ranges = append(areaPrefixes.Ranges, prefix.Masked())
+		}
+		if ipv4.TransferNetwork != nil {
+			prefix, err := netip.ParsePrefix(*ipv4.TransferNetwork)
+			if err != nil {
+				return nil, fmt.Errorf("parsing transfer network %q: %w", *ipv4.TransferNetwork, err)
+			}
+			prefix = prefix.Masked()
+			areaPrefixes.TransferNetwork = &prefix
+		}
+		areaPrefixes.MinPrefixLength = ipv4.MinPrefixLen
+		areaPrefixes.MaxPrefixLength = ipv4.MaxPrefixLen
+	}
+
+	projects, err := client.ListNetworkAreaProjectsExecute(ctx, organizationId, areaId)
+	if err != nil {
+		return nil, fmt.Errorf("listing projects of network area: %w", err)
+	}
+	for _, projectId := range projects.GetItems() {
+		networks, err := client.ListNetworksExecute(ctx, projectId)
+		if err != nil {
+			return nil, fmt.Errorf("listing networks of project %q: %w", projectId, err)
+		}
+		for _, network := range networks.GetItems() {
+			areaNetwork := AreaNetwork{
+				ProjectId: projectId,
+				NetworkId: network.GetNetworkId(),
+			}
+			for _, p := range network.GetPrefixes() {
+				prefix, err := netip.ParsePrefix(p)
+				if err != nil {
+					return nil, fmt.Errorf("parsing prefix %q of network %q: %w", p, network.GetNetworkId(), err)
+				}
+				areaNetwork.Prefixes = append(areaNetwork.Prefixes, prefix.Masked())
+			}
+			areaPrefixes.Networks = append(areaPrefixes.Networks, areaNetwork)
+		}
+	}
+	return areaPrefixes, nil
+}
+
+// CheckPrefix returns an error if the prefix is not within the network ranges of the area, does not
+// satisfy its prefix length limits or overlaps with a network in use. The network with ID excludeNetworkId is ignored.
+func (a *AreaPrefixes) CheckPrefix(prefix netip.Prefix, excludeNetworkId string) error {
+	prefix = prefix.Masked()
+	if !prefix.Addr().Is4() {
+		return fmt.Errorf("prefix %s is not an IPv4 prefix", prefix)
+	}
+	if a.MinPrefixLength != nil && int64(prefix.Bits()) < *a.MinPrefixLength {
+		return fmt.Errorf("prefix length of %s is smaller than the minimum prefix length %d of the network area", prefix, *a.MinPrefixLength)
+	}
+	if a.MaxPrefixLength != nil && int64(prefix.Bits()) > *a.MaxPrefixLength {
+		return fmt.Errorf("prefix length of %s is greater than the maximum prefix length %d of the network area", prefix, *a.MaxPrefixLength)
+	}
+	if !slices.ContainsFunc(a.Ranges, func(networkRange netip.Prefix) bool { return containsPrefix(networkRange, prefix) }) {
+		ranges := make([]string, 0, len(a.Ranges))
+		for _, networkRange := range a.Ranges {
+			ranges = append(ranges, networkRange.String())
+		}
+		return fmt.Errorf("prefix %s is not within the network ranges of the network area: %s", prefix, strings.Join(ranges, ", "))
+	}
+	if a.TransferNetwork != nil && a.TransferNetwork.Overlaps(prefix) {
+		return fmt.Errorf("prefix %s overlaps with the transfer network %s of the network area", prefix, a.TransferNetwork)
+	}
+	for _, network := range a.Networks {
+		if excludeNetworkId != "" && network.NetworkId == excludeNetworkId {
+			continue
+		}
+		for _, used := range network.Prefixes {
+			if used.Overlaps(prefix) {
+				return fmt.Errorf("prefix %s overlaps with prefix %s of network %q in project %q", prefix, used, network.NetworkId, network.ProjectId)
+			}
+		}
+	}
+	return nil
+}
+
+// FreePrefixes returns up to limit prefixes of the given length, in ascending order, which are within the network
+// ranges of the area and do not overlap with the transfer network or any network in use. A limit of 0 returns all.
+func (a *AreaPrefixes) FreePrefixes(length, limit int) []netip.Prefix {
+	used := []netip.Prefix{}
+	if a.TransferNetwork != nil {
+		used = append(used, *a.TransferNetwork)
+	}
+	for _, network := range a.Networks {
+		used = append(used, network.Prefixes...)
+	}
+
+	ranges := slices.Clone(a.Ranges)
+	slices.SortFunc(ranges, func(x, y netip.Prefix) int { return x.Addr().Compare(y.Addr()) })
+
+	free := []netip.Prefix{}
+	if length < 0 || length > 32 {
+		return free
+	}
+	size := uint64(1) << (32 - length)
+	for _, networkRange := range ranges {
+		if !networkRange.Addr().Is4() || networkRange.Bits() > length {
+			continue
+		}
+		start := ipv4ToUint(networkRange.Addr())
+		end := start + uint64(1)<<(32-networkRange.Bits())
+		for candidate := start; candidate < end; {
+			if limit > 0 && len(free) >= limit {
+				return free
+			}
+			prefix := netip.PrefixFrom(uintToIpv4(candidate), length)
+			overlapIdx := slices.IndexFunc(used, prefix.Overlaps)
+			if overlapIdx < 0 {
+				free = append(free, prefix)
+				candidate += size
+				continue
+			}
+			// Skip the whole overlapping prefix, keeping the candidates aligned to their size
+			overlap := used[overlapIdx]
+			overlapEnd := ipv4ToUint(overlap.Addr()) + uint64(1)<<(32-overlap.Bits())
+			candidate = max(candidate+size, (overlapEnd+size-1)/size*size)
+		}
+	}
+	return free
+}
+
+func containsPrefix(outer, inner netip.Prefix) bool {
+	return outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+func ipv4ToUint(addr netip.Addr) uint64 {
+	b := addr.As4()
+	return uint64(binary.BigEndian.Uint32(b[:]))
+}
+
+func uintToIpv4(value uint64) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(value)) // nolint:gosec // candidates are within an IPv4 network range
+	return netip.AddrFrom4(b)
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+)
+
+func testAreaPrefixes() *AreaPrefixes {
+	transferNetwork := netip.MustParsePrefix("10.0.255.0/24")
+	return &AreaPrefixes{
+		Ranges: []netip.Prefix{
+			netip.MustParsePrefix("10.1.0.0/24"),
+			netip.MustParsePrefix("10.0.0.0/16"),
+		},
+		TransferNetwork: &transferNetwork,
+		MinPrefixLength: utils.Ptr(int64(22)),
+		MaxPrefixLength: utils.Ptr(int64(29)),
+		Networks: []AreaNetwork{
+			{
+				ProjectId: "pid-1",
+				NetworkId: "nid-1",
+				Prefixes:  []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24")},
+			},
+			{
+				ProjectId: "pid-2",
+				NetworkId: "nid-2",
+				Prefixes:  []netip.Prefix{netip.MustParsePrefix("10.0.1.16/28")},
+			},
+		},
+	}
+}
+
+func TestCheckPrefix(t *testing.T) {
+	tests := []struct {
+		description      string
+		prefix           string
+		excludeNetworkId string
+		isValid          bool
+	}{
+		{"free", "10.0.2.0/24", "", true},
+		{"free_in_second_range", "10.1.0.0/25", "", true},
+		{"outside_ranges", "192.168.0.0/24", "", false},
+		{"larger_than_range", "10.1.0.0/23", "", false},
+		{"overlaps_network", "10.0.1.0/24", "", false},
+		{"within_network", "10.0.0.64/26", "", false},
+		{"overlaps_excluded_network", "10.0.0.0/24", "nid-1", true},
+		{"overlaps_transfer_network", "10.0.255.128/25", "", false},
+		{"below_min_prefix_length", "10.0.0.0/21", "", false},
+		{"above_max_prefix_length", "10.0.2.0/30", "", false},
+		{"ipv6", "fd00::/64", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := testAreaPrefixes().CheckPrefix(netip.MustParsePrefix(tt.prefix), tt.excludeNetworkId)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestFreePrefixes(t *testing.T) {
+	tests := []struct {
+		description string
+		length      int
+		limit       int
+		expected    []string
+	}{
+		{
+			"skips_used_prefixes",
+			24,
+			3,
+			[]string{"10.0.2.0/24", "10.0.3.0/24", "10.0.4.0/24"},
+		},
+		{
+			"fills_gaps",
+			28,
+			4,
+			[]string{"10.0.1.0/28", "10.0.1.32/28", "10.0.1.48/28", "10.0.1.64/28"},
+		},
+		{
+			"no_free_prefix",
+			17,
+			0,
+			[]string{},
+		},
+		{
+			"invalid_length",
+			33,
+			0,
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := testAreaPrefixes().FreePrefixes(tt.length, tt.limit)
+			outputStrings := []string{}
+			for _, prefix := range output {
+				outputStrings = append(outputStrings, prefix.String())
+			}
+			diff := cmp.Diff(outputStrings, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFreePrefixesWithoutLimit(t *testing.T) {
+	output := testAreaPrefixes().FreePrefixes(24, 0)
+	// All /24 of the /16 range except the ones in use and the transfer network, followed by the second range
+	if len(output) != 254 {
+		t.Fatalf("Expected 254 free prefixes, got %d", len(output))
+	}
+	if output[0].String() != "10.0.2.0/24" || output[len(output)-1].String() != "10.1.0.0/24" {
+		t.Fatalf("Expected free prefixes from 10.0.2.0/24 to 10.1.0.0/24, got %s to %s", output[0], output[len(output)-1])
+	}
+}
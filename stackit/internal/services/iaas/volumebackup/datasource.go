@@ -0,0 +1,319 @@
+package volumebackup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &volumeBackupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &volumeBackupDataSource{}
+)
+
+type DataSourceModel struct {
+	Id               types.String `tfsdk:"id"` // needed by TF
+	ProjectId        types.String `tfsdk:"project_id"`
+	BackupId         types.String `tfsdk:"backup_id"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	LabelSelector    types.String `tfsdk:"label_selector"`
+	VolumeId         types.String `tfsdk:"volume_id"`
+	SnapshotId       types.String `tfsdk:"snapshot_id"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	Name             types.String `tfsdk:"name"`
+	Labels           types.Map    `tfsdk:"labels"`
+	Size             types.Int64  `tfsdk:"size"`
+	Status           types.String `tfsdk:"status"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+// NewVolumeBackupDataSource is a helper function to simplify the provider implementation.
+func NewVolumeBackupDataSource() datasource.DataSource {
+	return &volumeBackupDataSource{}
+}
+
+// volumeBackupDataSource is the data source implementation.
+type volumeBackupDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *volumeBackupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_backup"
+}
+
+func (d *volumeBackupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+func (d *volumeBackupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("backup_id"),
+			path.MatchRoot("name_regex"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("backup_id"),
+			path.MatchRoot("label_selector"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("backup_id"),
+			path.MatchRoot("volume_id"),
+		),
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("backup_id"),
+			path.MatchRoot("name_regex"),
+			path.MatchRoot("label_selector"),
+			path.MatchRoot("volume_id"),
+		),
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *volumeBackupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Volume backup data source schema. Either looks up a backup by `backup_id` or returns the most recently created available backup matching `name_regex`, `label_selector` and `volume_id`. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`,`backup_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the backup is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Description: "The backup ID to fetch directly.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match against backup names. The most recently created available match is returned.",
+				Optional:    true,
+			},
+			"label_selector": schema.StringAttribute{
+				Description: "Label selector to filter backups by, e.g. `env=prod,tier=db`. The most recently created available match is returned.",
+				Optional:    true,
+			},
+			"volume_id": schema.StringAttribute{
+				Description: "The ID of the volume the backup was created from. If set, only backups of this volume are considered.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The ID of the snapshot the backup was created from.",
+				Computed:    true,
+			},
+			"availability_zone": schema.StringAttribute{
+				Description: "The availability zone of the backup.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the backup.",
+				Computed:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels are key-value string pairs which can be attached to a resource container",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the backup in GB.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the backup.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date-time when the backup was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *volumeBackupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	backupId := model.BackupId.ValueString()
+	nameRegex := model.NameRegex.ValueString()
+	labelSelector := model.LabelSelector.ValueString()
+	volumeId := model.VolumeId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+	ctx = tflog.SetField(ctx, "name_regex", nameRegex)
+	ctx = tflog.SetField(ctx, "label_selector", labelSelector)
+	ctx = tflog.SetField(ctx, "volume_id", volumeId)
+
+	var backupResp *iaas.Backup
+	var err error
+
+	if backupId != "" {
+		backupResp, err = d.client.GetBackup(ctx, projectId, backupId).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading volume backup",
+				fmt.Sprintf("Backup with ID %q does not exist in project %q.", backupId, projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		var compiledRegex *regexp.Regexp
+		if nameRegex != "" {
+			compiledRegex, err = regexp.Compile(nameRegex)
+			if err != nil {
+				core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume backup", fmt.Sprintf("Invalid name_regex: %v", err))
+				return
+			}
+		}
+
+		listReq := d.client.ListBackups(ctx, projectId)
+		if labelSelector != "" {
+			listReq = listReq.LabelSelector(labelSelector)
+		}
+		backupList, err := listReq.Execute()
+		if err != nil {
+			utils.LogError(ctx, &resp.Diagnostics, err, "Reading volume backup", fmt.Sprintf("Backups could not be listed in project %q.", projectId), nil)
+			return
+		}
+
+		backupResp = selectLatestBackup(backupList.Items, compiledRegex, volumeId)
+		if backupResp == nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume backup", "No backup found matching name_regex, label_selector and volume_id.")
+			return
+		}
+	}
+
+	err = mapDataSourceFields(ctx, backupResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume backup", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "volume backup read")
+}
+
+// selectLatestBackup returns the most recently created available backup matching the name regex and volume ID, if set.
+// Backups which are still being created or failed are skipped, as they can't be restored from.
+func selectLatestBackup(backups *[]iaas.Backup, nameRegex *regexp.Regexp, volumeId string) *iaas.Backup {
+	if backups == nil {
+		return nil
+	}
+	var latest *iaas.Backup
+	for i := range *backups {
+		backup := &(*backups)[i]
+		if nameRegex != nil && (backup.Name == nil || !nameRegex.MatchString(*backup.Name)) {
+			continue
+		}
+		if volumeId != "" && (backup.VolumeId == nil || *backup.VolumeId != volumeId) {
+			continue
+		}
+		if backup.GetStatus() != wait.BackupAvailableStatus {
+			continue
+		}
+		if latest == nil || backup.GetCreatedAt().After(latest.GetCreatedAt()) {
+			latest = backup
+		}
+	}
+	return latest
+}
+
+func mapDataSourceFields(ctx context.Context, backupResp *iaas.Backup, model *DataSourceModel) error {
+	if backupResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var backupId string
+	if model.BackupId.ValueString() != "" {
+		backupId = model.BackupId.ValueString()
+	} else if backupResp.Id != nil {
+		backupId = *backupResp.Id
+	} else {
+		return fmt.Errorf("backup id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), backupId)
+
+	labels, err := iaasUtils.MapLabels(ctx, backupResp.Labels, types.MapNull(types.StringType))
+	if err != nil {
+		return err
+	}
+
+	model.CreatedAt = types.StringNull()
+	if backupResp.CreatedAt != nil {
+		model.CreatedAt = types.StringValue(backupResp.CreatedAt.Format(time.RFC3339))
+	}
+
+	model.BackupId = types.StringValue(backupId)
+	model.VolumeId = types.StringPointerValue(backupResp.VolumeId)
+	model.SnapshotId = types.StringPointerValue(backupResp.SnapshotId)
+	model.AvailabilityZone = types.StringPointerValue(backupResp.AvailabilityZone)
+	model.Name = types.StringPointerValue(backupResp.Name)
+	model.Labels = labels
+	model.Size = types.Int64PointerValue(backupResp.Size)
+	model.Status = types.StringPointerValue(backupResp.Status)
+	return nil
+}
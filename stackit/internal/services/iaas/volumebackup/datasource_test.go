@@ -0,0 +1,206 @@
+package volumebackup
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestSelectLatestBackup(t *testing.T) {
+	older := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	newest := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	backups := []iaas.Backup{
+		{Id: utils.Ptr("old-db"), Name: utils.Ptr("db-golden"), VolumeId: utils.Ptr("vid-1"), CreatedAt: &older, Status: utils.Ptr("AVAILABLE")},
+		{Id: utils.Ptr("new-db"), Name: utils.Ptr("db-golden"), VolumeId: utils.Ptr("vid-2"), CreatedAt: &newer, Status: utils.Ptr("AVAILABLE")},
+		{Id: utils.Ptr("web"), Name: utils.Ptr("web-golden"), VolumeId: utils.Ptr("vid-1"), CreatedAt: &older, Status: utils.Ptr("AVAILABLE")},
+		{Id: utils.Ptr("unnamed"), VolumeId: utils.Ptr("vid-3"), Status: utils.Ptr("AVAILABLE")},
+		{Id: utils.Ptr("creating"), Name: utils.Ptr("app-golden"), VolumeId: utils.Ptr("vid-4"), CreatedAt: &newest, Status: utils.Ptr("CREATING")},
+		{Id: utils.Ptr("error"), Name: utils.Ptr("app-golden"), VolumeId: utils.Ptr("vid-4"), CreatedAt: &newest, Status: utils.Ptr("ERROR")},
+		{Id: utils.Ptr("available"), Name: utils.Ptr("app-golden"), VolumeId: utils.Ptr("vid-4"), CreatedAt: &older, Status: utils.Ptr("AVAILABLE")},
+	}
+
+	tests := []struct {
+		description string
+		input       *[]iaas.Backup
+		nameRegex   *regexp.Regexp
+		volumeId    string
+		expectedId  *string
+	}{
+		{
+			"no_filter",
+			&backups,
+			nil,
+			"",
+			utils.Ptr("new-db"),
+		},
+		{
+			"name_regex",
+			&backups,
+			regexp.MustCompile("^web-"),
+			"",
+			utils.Ptr("web"),
+		},
+		{
+			"volume_id",
+			&backups,
+			regexp.MustCompile("^db-"),
+			"vid-1",
+			utils.Ptr("old-db"),
+		},
+		{
+			"unnamed_backup_not_matched_by_regex",
+			&backups,
+			regexp.MustCompile(".*"),
+			"vid-3",
+			nil,
+		},
+		{
+			"not_available_skipped",
+			&backups,
+			regexp.MustCompile("^app-"),
+			"",
+			utils.Ptr("available"),
+		},
+		{
+			"no_match",
+			&backups,
+			regexp.MustCompile("^none-"),
+			"",
+			nil,
+		},
+		{
+			"nil_list",
+			nil,
+			nil,
+			"",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := selectLatestBackup(tt.input, tt.nameRegex, tt.volumeId)
+			var outputId *string
+			if output != nil {
+				outputId = output.Id
+			}
+			diff := cmp.Diff(outputId, tt.expectedId)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapDataSourceFields(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		description string
+		state       DataSourceModel
+		input       *iaas.Backup
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				BackupId:  types.StringValue("bid"),
+			},
+			&iaas.Backup{
+				Id: utils.Ptr("bid"),
+			},
+			DataSourceModel{
+				Id:               types.StringValue("pid,bid"),
+				ProjectId:        types.StringValue("pid"),
+				BackupId:         types.StringValue("bid"),
+				VolumeId:         types.StringNull(),
+				SnapshotId:       types.StringNull(),
+				AvailabilityZone: types.StringNull(),
+				Name:             types.StringNull(),
+				Labels:           types.MapNull(types.StringType),
+				Size:             types.Int64Null(),
+				Status:           types.StringNull(),
+				CreatedAt:        types.StringNull(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				NameRegex: types.StringValue("^name$"),
+			},
+			&iaas.Backup{
+				Id:               utils.Ptr("bid"),
+				VolumeId:         utils.Ptr("vid"),
+				SnapshotId:       utils.Ptr("sid"),
+				AvailabilityZone: utils.Ptr("eu01-1"),
+				Name:             utils.Ptr("name"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+				Size:      utils.Ptr(int64(10)),
+				Status:    utils.Ptr("AVAILABLE"),
+				CreatedAt: &createdAt,
+			},
+			DataSourceModel{
+				Id:               types.StringValue("pid,bid"),
+				ProjectId:        types.StringValue("pid"),
+				BackupId:         types.StringValue("bid"),
+				NameRegex:        types.StringValue("^name$"),
+				VolumeId:         types.StringValue("vid"),
+				SnapshotId:       types.StringValue("sid"),
+				AvailabilityZone: types.StringValue("eu01-1"),
+				Name:             types.StringValue("name"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+				Size:      types.Int64Value(10),
+				Status:    types.StringValue("AVAILABLE"),
+				CreatedAt: types.StringValue("2025-01-02T03:04:05Z"),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			DataSourceModel{},
+			nil,
+			DataSourceModel{},
+			false,
+		},
+		{
+			"no_resource_id",
+			DataSourceModel{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.Backup{},
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapDataSourceFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
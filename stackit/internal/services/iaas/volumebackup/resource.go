@@ -0,0 +1,541 @@
+package volumebackup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &volumeBackupResource{}
+	_ resource.ResourceWithConfigure   = &volumeBackupResource{}
+	_ resource.ResourceWithImportState = &volumeBackupResource{}
+
+	SupportedSourceTypes = []string{"volume", "snapshot"}
+)
+
+type Model struct {
+	Id               types.String `tfsdk:"id"` // needed by TF
+	ProjectId        types.String `tfsdk:"project_id"`
+	BackupId         types.String `tfsdk:"backup_id"`
+	Name             types.String `tfsdk:"name"`
+	Labels           types.Map    `tfsdk:"labels"`
+	Source           types.Object `tfsdk:"source"`
+	VolumeId         types.String `tfsdk:"volume_id"`
+	SnapshotId       types.String `tfsdk:"snapshot_id"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	Size             types.Int64  `tfsdk:"size"`
+	Status           types.String `tfsdk:"status"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+// Struct corresponding to Model.Source
+type sourceModel struct {
+	Type types.String `tfsdk:"type"`
+	Id   types.String `tfsdk:"id"`
+}
+
+// Types corresponding to sourceModel
+var sourceTypes = map[string]attr.Type{
+	"type": basetypes.StringType{},
+	"id":   basetypes.StringType{},
+}
+
+// NewVolumeBackupResource is a helper function to simplify the provider implementation.
+func NewVolumeBackupResource() resource.Resource {
+	return &volumeBackupResource{}
+}
+
+// volumeBackupResource is the resource implementation.
+type volumeBackupResource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *volumeBackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_backup"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *volumeBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *volumeBackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Volume backup resource schema. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`project_id`,`backup_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the backup is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Description: "The backup ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the backup.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.LengthAtMost(63),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[A-Za-z0-9]+((-|_|\s|\.)[A-Za-z0-9]+)*$`),
+						"must match expression"),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels are key-value string pairs which can be attached to a resource container",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source": schema.SingleNestedAttribute{
+				Description: "The source of the backup. It can be either a volume or a snapshot.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The type of the source. " + utils.SupportedValuesDocumentation(SupportedSourceTypes),
+						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(SupportedSourceTypes...),
+						},
+					},
+					"id": schema.StringAttribute{
+						Description: "The ID of the source, e.g. volume ID",
+						Required:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							validate.UUID(),
+							validate.NoSeparator(),
+						},
+					},
+				},
+			},
+			"volume_id": schema.StringAttribute{
+				Description: "The ID of the volume the backup was created from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The ID of the snapshot the backup was created from.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"availability_zone": schema.StringAttribute{
+				Description: "The availability zone of the backup.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the backup in GB.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the backup.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date-time when the backup was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *volumeBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	var source = &sourceModel{}
+	if !(model.Source.IsNull() || model.Source.IsUnknown()) {
+		diags = model.Source.As(ctx, source, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Generate API request body from model
+	payload, err := toCreatePayload(ctx, &model, source)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume backup", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	// Create new backup
+	backup, err := r.client.CreateBackup(ctx, projectId).CreateBackupPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume backup", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	backupId := *backup.Id
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+
+	backup, err = wait.CreateBackupWaitHandler(ctx, r.client, projectId, backupId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume backup", fmt.Sprintf("backup creation waiting: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, backup, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume backup", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Volume backup created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *volumeBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	backupId := model.BackupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+
+	backupResp, err := r.client.GetBackup(ctx, projectId, backupId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume backup", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// Map response body to schema
+	err = mapFields(ctx, backupResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume backup", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "volume backup read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *volumeBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	backupId := model.BackupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+
+	// Retrieve values from state
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from model
+	payload, err := toUpdatePayload(ctx, &model, stateModel.Labels)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume backup", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	// Update existing backup
+	updatedBackup, err := r.client.UpdateBackup(ctx, projectId, backupId).UpdateBackupPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume backup", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, updatedBackup, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating volume backup", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "volume backup updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *volumeBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	backupId := model.BackupId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+
+	// Delete existing backup
+	err := r.client.DeleteBackup(ctx, projectId, backupId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting volume backup", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	_, err = wait.DeleteBackupWaitHandler(ctx, r.client, projectId, backupId).WaitWithContext(ctx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting volume backup", fmt.Sprintf("backup deletion waiting: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "volume backup deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,backup_id
+func (r *volumeBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing volume backup",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[backup_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	projectId := idParts[0]
+	backupId := idParts[1]
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "backup_id", backupId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("backup_id"), backupId)...)
+	tflog.Info(ctx, "volume backup state imported")
+}
+
+func mapFields(ctx context.Context, backupResp *iaas.Backup, model *Model) error {
+	if backupResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var backupId string
+	if model.BackupId.ValueString() != "" {
+		backupId = model.BackupId.ValueString()
+	} else if backupResp.Id != nil {
+		backupId = *backupResp.Id
+	} else {
+		return fmt.Errorf("backup id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), backupId)
+
+	labels, err := iaasUtils.MapLabels(ctx, backupResp.Labels, model.Labels)
+	if err != nil {
+		return err
+	}
+
+	// The API doesn't return the source the backup was created from.
+	// If it is not known yet (e.g. after an import), it is derived from the snapshot and volume IDs
+	if model.Source.IsNull() || model.Source.IsUnknown() {
+		sourceObject := types.ObjectNull(sourceTypes)
+		var sourceValues map[string]attr.Value
+		if snapshotId := backupResp.GetSnapshotId(); snapshotId != "" {
+			sourceValues = map[string]attr.Value{
+				"type": types.StringValue("snapshot"),
+				"id":   types.StringValue(snapshotId),
+			}
+		} else if volumeId := backupResp.GetVolumeId(); volumeId != "" {
+			sourceValues = map[string]attr.Value{
+				"type": types.StringValue("volume"),
+				"id":   types.StringValue(volumeId),
+			}
+		}
+		if sourceValues != nil {
+			var diags diag.Diagnostics
+			sourceObject, diags = types.ObjectValue(sourceTypes, sourceValues)
+			if diags.HasError() {
+				return fmt.Errorf("creating source: %w", core.DiagsToError(diags))
+			}
+		}
+		model.Source = sourceObject
+	}
+
+	model.CreatedAt = types.StringNull()
+	if backupResp.CreatedAt != nil {
+		model.CreatedAt = types.StringValue(backupResp.CreatedAt.Format(time.RFC3339))
+	}
+
+	model.BackupId = types.StringValue(backupId)
+	model.Name = types.StringPointerValue(backupResp.Name)
+	// Workaround for backups with no names which return an empty string instead of nil
+	if name := backupResp.Name; name != nil && *name == "" {
+		model.Name = types.StringNull()
+	}
+	model.Labels = labels
+	model.VolumeId = types.StringPointerValue(backupResp.VolumeId)
+	model.SnapshotId = types.StringPointerValue(backupResp.SnapshotId)
+	model.AvailabilityZone = types.StringPointerValue(backupResp.AvailabilityZone)
+	model.Size = types.Int64PointerValue(backupResp.Size)
+	model.Status = types.StringPointerValue(backupResp.Status)
+	return nil
+}
+
+func toCreatePayload(ctx context.Context, model *Model, source *sourceModel) (*iaas.CreateBackupPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	if source == nil || source.Id.IsNull() || source.Type.IsNull() {
+		return nil, fmt.Errorf("source is missing")
+	}
+
+	labels, err := conversion.ToStringInterfaceMap(ctx, model.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("converting to Go map: %w", err)
+	}
+
+	return &iaas.CreateBackupPayload{
+		Labels: &labels,
+		Name:   conversion.StringValueToPointer(model.Name),
+		Source: &iaas.BackupSource{
+			Id:   conversion.StringValueToPointer(source.Id),
+			Type: conversion.StringValueToPointer(source.Type),
+		},
+	}, nil
+}
+
+func toUpdatePayload(ctx context.Context, model *Model, currentLabels types.Map) (*iaas.UpdateBackupPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	labels, err := conversion.ToJSONMapPartialUpdatePayload(ctx, currentLabels, model.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("converting to Go map: %w", err)
+	}
+
+	return &iaas.UpdateBackupPayload{
+		Name:   conversion.StringValueToPointer(model.Name),
+		Labels: &labels,
+	}, nil
+}
@@ -0,0 +1,300 @@
+package volumebackup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapFields(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	volumeSource := types.ObjectValueMust(sourceTypes, map[string]attr.Value{
+		"type": types.StringValue("volume"),
+		"id":   types.StringValue("vid"),
+	})
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaas.Backup
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				BackupId:  types.StringValue("bid"),
+			},
+			&iaas.Backup{
+				Id: utils.Ptr("bid"),
+			},
+			Model{
+				Id:               types.StringValue("pid,bid"),
+				ProjectId:        types.StringValue("pid"),
+				BackupId:         types.StringValue("bid"),
+				Name:             types.StringNull(),
+				Labels:           types.MapNull(types.StringType),
+				Source:           types.ObjectNull(sourceTypes),
+				VolumeId:         types.StringNull(),
+				SnapshotId:       types.StringNull(),
+				AvailabilityZone: types.StringNull(),
+				Size:             types.Int64Null(),
+				Status:           types.StringNull(),
+				CreatedAt:        types.StringNull(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				Source:    volumeSource,
+			},
+			&iaas.Backup{
+				Id:               utils.Ptr("bid"),
+				Name:             utils.Ptr("name"),
+				VolumeId:         utils.Ptr("vid"),
+				SnapshotId:       utils.Ptr("sid"),
+				AvailabilityZone: utils.Ptr("eu01-1"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+				Size:      utils.Ptr(int64(10)),
+				Status:    utils.Ptr("AVAILABLE"),
+				CreatedAt: &createdAt,
+			},
+			Model{
+				Id:        types.StringValue("pid,bid"),
+				ProjectId: types.StringValue("pid"),
+				BackupId:  types.StringValue("bid"),
+				Name:      types.StringValue("name"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+				Source:           volumeSource,
+				VolumeId:         types.StringValue("vid"),
+				SnapshotId:       types.StringValue("sid"),
+				AvailabilityZone: types.StringValue("eu01-1"),
+				Size:             types.Int64Value(10),
+				Status:           types.StringValue("AVAILABLE"),
+				CreatedAt:        types.StringValue("2025-01-02T03:04:05Z"),
+			},
+			true,
+		},
+		{
+			"imported_from_snapshot",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				BackupId:  types.StringValue("bid"),
+			},
+			&iaas.Backup{
+				Id:         utils.Ptr("bid"),
+				VolumeId:   utils.Ptr("vid"),
+				SnapshotId: utils.Ptr("sid"),
+			},
+			Model{
+				Id:        types.StringValue("pid,bid"),
+				ProjectId: types.StringValue("pid"),
+				BackupId:  types.StringValue("bid"),
+				Name:      types.StringNull(),
+				Labels:    types.MapNull(types.StringType),
+				Source: types.ObjectValueMust(sourceTypes, map[string]attr.Value{
+					"type": types.StringValue("snapshot"),
+					"id":   types.StringValue("sid"),
+				}),
+				VolumeId:         types.StringValue("vid"),
+				SnapshotId:       types.StringValue("sid"),
+				AvailabilityZone: types.StringNull(),
+				Size:             types.Int64Null(),
+				Status:           types.StringNull(),
+				CreatedAt:        types.StringNull(),
+			},
+			true,
+		},
+		{
+			"imported_from_volume",
+			Model{
+				ProjectId: types.StringValue("pid"),
+				BackupId:  types.StringValue("bid"),
+			},
+			&iaas.Backup{
+				Id:       utils.Ptr("bid"),
+				VolumeId: utils.Ptr("vid"),
+			},
+			Model{
+				Id:               types.StringValue("pid,bid"),
+				ProjectId:        types.StringValue("pid"),
+				BackupId:         types.StringValue("bid"),
+				Name:             types.StringNull(),
+				Labels:           types.MapNull(types.StringType),
+				Source:           volumeSource,
+				VolumeId:         types.StringValue("vid"),
+				SnapshotId:       types.StringNull(),
+				AvailabilityZone: types.StringNull(),
+				Size:             types.Int64Null(),
+				Status:           types.StringNull(),
+				CreatedAt:        types.StringNull(),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_resource_id",
+			Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.Backup{},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		source      *sourceModel
+		expected    *iaas.CreateBackupPayload
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&Model{
+				Name: types.StringValue("name"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+			},
+			&sourceModel{
+				Type: types.StringValue("snapshot"),
+				Id:   types.StringValue("sid"),
+			},
+			&iaas.CreateBackupPayload{
+				Name: utils.Ptr("name"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+				Source: &iaas.BackupSource{
+					Type: utils.Ptr("snapshot"),
+					Id:   utils.Ptr("sid"),
+				},
+			},
+			true,
+		},
+		{
+			"missing_source",
+			&Model{
+				Name: types.StringValue("name"),
+			},
+			&sourceModel{
+				Type: types.StringNull(),
+				Id:   types.StringNull(),
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			&sourceModel{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(context.Background(), tt.input, tt.source)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *iaas.UpdateBackupPayload
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&Model{
+				Name: types.StringValue("name"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+			},
+			&iaas.UpdateBackupPayload{
+				Name: utils.Ptr("name"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(context.Background(), tt.input, types.MapNull(types.StringType))
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
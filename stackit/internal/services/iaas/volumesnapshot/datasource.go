@@ -0,0 +1,307 @@
+package volumesnapshot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	iaasUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &volumeSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &volumeSnapshotDataSource{}
+)
+
+type DataSourceModel struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	ProjectId     types.String `tfsdk:"project_id"`
+	SnapshotId    types.String `tfsdk:"snapshot_id"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+	LabelSelector types.String `tfsdk:"label_selector"`
+	VolumeId      types.String `tfsdk:"volume_id"`
+	Name          types.String `tfsdk:"name"`
+	Labels        types.Map    `tfsdk:"labels"`
+	Size          types.Int64  `tfsdk:"size"`
+	Status        types.String `tfsdk:"status"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+// NewVolumeSnapshotDataSource is a helper function to simplify the provider implementation.
+func NewVolumeSnapshotDataSource() datasource.DataSource {
+	return &volumeSnapshotDataSource{}
+}
+
+// volumeSnapshotDataSource is the data source implementation.
+type volumeSnapshotDataSource struct {
+	client *iaas.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *volumeSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_snapshot"
+}
+
+func (d *volumeSnapshotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := iaasUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "iaas client configured")
+}
+
+func (d *volumeSnapshotDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("snapshot_id"),
+			path.MatchRoot("name_regex"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("snapshot_id"),
+			path.MatchRoot("label_selector"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("snapshot_id"),
+			path.MatchRoot("volume_id"),
+		),
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("snapshot_id"),
+			path.MatchRoot("name_regex"),
+			path.MatchRoot("label_selector"),
+			path.MatchRoot("volume_id"),
+		),
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *volumeSnapshotDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Volume snapshot data source schema. Either looks up a snapshot by `snapshot_id` or returns the most recently created available snapshot matching `name_regex`, `label_selector` and `volume_id`. Must have a `region` specified in the provider configuration."
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`,`snapshot_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the snapshot is associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The snapshot ID to fetch directly.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression to match against snapshot names. The most recently created available match is returned.",
+				Optional:    true,
+			},
+			"label_selector": schema.StringAttribute{
+				Description: "Label selector to filter snapshots by, e.g. `env=prod,tier=db`. The most recently created available match is returned.",
+				Optional:    true,
+			},
+			"volume_id": schema.StringAttribute{
+				Description: "The ID of the volume the snapshot is taken from. If set, only snapshots of this volume are considered.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the snapshot.",
+				Computed:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels are key-value string pairs which can be attached to a resource container",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "The size of the snapshot in GB.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the snapshot.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date-time when the snapshot was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *volumeSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	snapshotId := model.SnapshotId.ValueString()
+	nameRegex := model.NameRegex.ValueString()
+	labelSelector := model.LabelSelector.ValueString()
+	volumeId := model.VolumeId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "snapshot_id", snapshotId)
+	ctx = tflog.SetField(ctx, "name_regex", nameRegex)
+	ctx = tflog.SetField(ctx, "label_selector", labelSelector)
+	ctx = tflog.SetField(ctx, "volume_id", volumeId)
+
+	var snapshotResp *iaas.Snapshot
+	var err error
+
+	if snapshotId != "" {
+		snapshotResp, err = d.client.GetSnapshot(ctx, projectId, snapshotId).Execute()
+		if err != nil {
+			utils.LogError(
+				ctx,
+				&resp.Diagnostics,
+				err,
+				"Reading volume snapshot",
+				fmt.Sprintf("Snapshot with ID %q does not exist in project %q.", snapshotId, projectId),
+				map[int]string{
+					http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+				},
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	} else {
+		var compiledRegex *regexp.Regexp
+		if nameRegex != "" {
+			compiledRegex, err = regexp.Compile(nameRegex)
+			if err != nil {
+				core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume snapshot", fmt.Sprintf("Invalid name_regex: %v", err))
+				return
+			}
+		}
+
+		listReq := d.client.ListSnapshots(ctx, projectId)
+		if labelSelector != "" {
+			listReq = listReq.LabelSelector(labelSelector)
+		}
+		snapshotList, err := listReq.Execute()
+		if err != nil {
+			utils.LogError(ctx, &resp.Diagnostics, err, "Reading volume snapshot", fmt.Sprintf("Snapshots could not be listed in project %q.", projectId), nil)
+			return
+		}
+
+		snapshotResp = selectLatestSnapshot(snapshotList.Items, compiledRegex, volumeId)
+		if snapshotResp == nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume snapshot", "No snapshot found matching name_regex, label_selector and volume_id.")
+			return
+		}
+	}
+
+	err = mapDataSourceFields(ctx, snapshotResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading volume snapshot", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "volume snapshot read")
+}
+
+// selectLatestSnapshot returns the most recently created available snapshot matching the name regex and volume ID, if set.
+// Snapshots which are still being created or failed are skipped, as they can't be restored from.
+func selectLatestSnapshot(snapshots *[]iaas.Snapshot, nameRegex *regexp.Regexp, volumeId string) *iaas.Snapshot {
+	if snapshots == nil {
+		return nil
+	}
+	var latest *iaas.Snapshot
+	for i := range *snapshots {
+		snapshot := &(*snapshots)[i]
+		if nameRegex != nil && (snapshot.Name == nil || !nameRegex.MatchString(*snapshot.Name)) {
+			continue
+		}
+		if volumeId != "" && (snapshot.VolumeId == nil || *snapshot.VolumeId != volumeId) {
+			continue
+		}
+		if snapshot.GetStatus() != wait.SnapshotAvailableStatus {
+			continue
+		}
+		if latest == nil || snapshot.GetCreatedAt().After(latest.GetCreatedAt()) {
+			latest = snapshot
+		}
+	}
+	return latest
+}
+
+func mapDataSourceFields(ctx context.Context, snapshotResp *iaas.Snapshot, model *DataSourceModel) error {
+	if snapshotResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var snapshotId string
+	if model.SnapshotId.ValueString() != "" {
+		snapshotId = model.SnapshotId.ValueString()
+	} else if snapshotResp.Id != nil {
+		snapshotId = *snapshotResp.Id
+	} else {
+		return fmt.Errorf("snapshot id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), snapshotId)
+
+	labels, err := iaasUtils.MapLabels(ctx, snapshotResp.Labels, types.MapNull(types.StringType))
+	if err != nil {
+		return err
+	}
+
+	model.CreatedAt = types.StringNull()
+	if snapshotResp.CreatedAt != nil {
+		model.CreatedAt = types.StringValue(snapshotResp.CreatedAt.Format(time.RFC3339))
+	}
+
+	model.SnapshotId = types.StringValue(snapshotId)
+	model.VolumeId = types.StringPointerValue(snapshotResp.VolumeId)
+	model.Name = types.StringPointerValue(snapshotResp.Name)
+	model.Labels = labels
+	model.Size = types.Int64PointerValue(snapshotResp.Size)
+	model.Status = types.StringPointerValue(snapshotResp.Status)
+	return nil
+}
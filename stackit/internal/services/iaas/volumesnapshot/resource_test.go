@@ -0,0 +1,235 @@
+package volumesnapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
+)
+
+func TestMapFields(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaas.Snapshot
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			Model{
+				ProjectId:  types.StringValue("pid"),
+				SnapshotId: types.StringValue("sid"),
+			},
+			&iaas.Snapshot{
+				Id: utils.Ptr("sid"),
+			},
+			Model{
+				Id:         types.StringValue("pid,sid"),
+				ProjectId:  types.StringValue("pid"),
+				SnapshotId: types.StringValue("sid"),
+				VolumeId:   types.StringNull(),
+				Name:       types.StringNull(),
+				Labels:     types.MapNull(types.StringType),
+				Size:       types.Int64Null(),
+				Status:     types.StringNull(),
+				CreatedAt:  types.StringNull(),
+			},
+			true,
+		},
+		{
+			"simple_values",
+			Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.Snapshot{
+				Id:       utils.Ptr("sid"),
+				VolumeId: utils.Ptr("vid"),
+				Name:     utils.Ptr("name"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+				Size:      utils.Ptr(int64(10)),
+				Status:    utils.Ptr("AVAILABLE"),
+				CreatedAt: &createdAt,
+			},
+			Model{
+				Id:         types.StringValue("pid,sid"),
+				ProjectId:  types.StringValue("pid"),
+				SnapshotId: types.StringValue("sid"),
+				VolumeId:   types.StringValue("vid"),
+				Name:       types.StringValue("name"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+				Size:      types.Int64Value(10),
+				Status:    types.StringValue("AVAILABLE"),
+				CreatedAt: types.StringValue("2025-01-02T03:04:05Z"),
+			},
+			true,
+		},
+		{
+			"empty_labels",
+			Model{
+				ProjectId:  types.StringValue("pid"),
+				SnapshotId: types.StringValue("sid"),
+				Labels:     types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			},
+			&iaas.Snapshot{
+				Id:   utils.Ptr("sid"),
+				Name: utils.Ptr(""),
+			},
+			Model{
+				Id:         types.StringValue("pid,sid"),
+				ProjectId:  types.StringValue("pid"),
+				SnapshotId: types.StringValue("sid"),
+				VolumeId:   types.StringNull(),
+				Name:       types.StringNull(),
+				Labels:     types.MapValueMust(types.StringType, map[string]attr.Value{}),
+				Size:       types.Int64Null(),
+				Status:     types.StringNull(),
+				CreatedAt:  types.StringNull(),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_resource_id",
+			Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaas.Snapshot{},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(context.Background(), tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *iaas.CreateSnapshotPayload
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&Model{
+				VolumeId: types.StringValue("vid"),
+				Name:     types.StringValue("name"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+			},
+			&iaas.CreateSnapshotPayload{
+				VolumeId: utils.Ptr("vid"),
+				Name:     utils.Ptr("name"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *iaas.UpdateSnapshotPayload
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&Model{
+				Name: types.StringValue("name"),
+				Labels: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+			},
+			&iaas.UpdateSnapshotPayload{
+				Name: utils.Ptr("name"),
+				Labels: &map[string]interface{}{
+					"key": "value",
+				},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toUpdatePayload(context.Background(), tt.input, types.MapNull(types.StringType))
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,428 @@
+package association
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaasalpha"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/features"
+	iaasalphaUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &routingTableAssociationResource{}
+	_ resource.ResourceWithConfigure   = &routingTableAssociationResource{}
+	_ resource.ResourceWithImportState = &routingTableAssociationResource{}
+)
+
+type Model struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	OrganizationId types.String `tfsdk:"organization_id"`
+	NetworkAreaId  types.String `tfsdk:"network_area_id"`
+	ProjectId      types.String `tfsdk:"project_id"`
+	NetworkId      types.String `tfsdk:"network_id"`
+	RoutingTableId types.String `tfsdk:"routing_table_id"`
+	Region         types.String `tfsdk:"region"`
+}
+
+// NewRoutingTableAssociationResource is a helper function to simplify the provider implementation.
+func NewRoutingTableAssociationResource() resource.Resource {
+	return &routingTableAssociationResource{}
+}
+
+// routingTableAssociationResource is the resource implementation.
+type routingTableAssociationResource struct {
+	client       *iaasalpha.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *routingTableAssociationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_table_association"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *routingTableAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	features.CheckExperimentEnabled(ctx, &r.providerData, features.RoutingTablesExperiment, "stackit_routing_table_association", core.Resource, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient := iaasalphaUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "IaaS alpha client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *routingTableAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Routing table association resource schema. Attaches a routing table of a network area to a network in one of the projects of the area. " +
+		"On deletion, the network is attached to the default routing table of the network area again. Must have a `region` specified in the provider configuration."
+	descriptionNote := "Do not set `routing_table_id` on the `stackit_network` resource for a network which is managed by this resource."
+	resp.Schema = schema.Schema{
+		Description:         fmt.Sprintf("%s %s", description, descriptionNote),
+		MarkdownDescription: features.AddExperimentDescription(fmt.Sprintf("%s\n\n~> %s", description, descriptionNote), features.RoutingTablesExperiment, core.Resource),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal resource ID. It is structured as \"`organization_id`,`region`,`network_area_id`,`project_id`,`network_id`\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "STACKIT organization ID to which the routing table is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"network_area_id": schema.StringAttribute{
+				Description: "The network area ID to which the routing table is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the network is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The ID of the network to which the routing table is attached.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"routing_table_id": schema.StringAttribute{
+				Description: "The ID of the routing table which is attached to the network.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+				// must be computed to allow for storing the override value from the provider
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *routingTableAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	projectId := model.ProjectId.ValueString()
+	networkId := model.NetworkId.ValueString()
+	routingTableId := model.RoutingTableId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+	ctx = tflog.SetField(ctx, "routing_table_id", routingTableId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	// Make sure the routing table belongs to the network area before attaching it
+	_, err := r.client.GetRoutingTableOfArea(ctx, organizationId, networkAreaId, region, routingTableId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating routing table association", fmt.Sprintf("Reading routing table %q of network area %q: %v", routingTableId, networkAreaId, err))
+		return
+	}
+
+	network, err := r.attachRoutingTable(ctx, projectId, region, networkId, routingTableId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating routing table association", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(network, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating routing table association", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Routing table association created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *routingTableAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	networkId := model.NetworkId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	network, err := r.client.GetNetwork(ctx, projectId, region, networkId).Execute()
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading routing table association", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(network, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading routing table association", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Routing table association read")
+}
+
+// Update attaches another routing table to the network and sets the updated Terraform state on success.
+func (r *routingTableAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	projectId := model.ProjectId.ValueString()
+	networkId := model.NetworkId.ValueString()
+	routingTableId := model.RoutingTableId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+	ctx = tflog.SetField(ctx, "routing_table_id", routingTableId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	_, err := r.client.GetRoutingTableOfArea(ctx, organizationId, networkAreaId, region, routingTableId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating routing table association", fmt.Sprintf("Reading routing table %q of network area %q: %v", routingTableId, networkAreaId, err))
+		return
+	}
+
+	network, err := r.attachRoutingTable(ctx, projectId, region, networkId, routingTableId)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating routing table association", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(network, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating routing table association", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Routing table association updated")
+}
+
+// Delete attaches the default routing table of the network area to the network and removes the Terraform state on success.
+func (r *routingTableAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationId := model.OrganizationId.ValueString()
+	networkAreaId := model.NetworkAreaId.ValueString()
+	projectId := model.ProjectId.ValueString()
+	networkId := model.NetworkId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	routingTables, err := r.client.ListRoutingTablesOfAreaExecute(ctx, organizationId, networkAreaId, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table association", fmt.Sprintf("Listing routing tables: %v", err))
+		return
+	}
+	defaultRoutingTableId, err := getDefaultRoutingTableId(routingTables)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table association", err.Error())
+		return
+	}
+	ctx = tflog.SetField(ctx, "routing_table_id", defaultRoutingTableId)
+
+	_, err = r.attachRoutingTable(ctx, projectId, region, networkId, defaultRoutingTableId)
+	if err != nil {
+		oapiErr, ok := err.(*oapierror.GenericOpenAPIError) //nolint:errorlint //complaining that error.As should be used to catch wrapped errors, but this error should not be wrapped
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Network not found, routing table association already removed")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting routing table association", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Routing table association deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: organization_id,region,network_area_id,project_id,network_id
+func (r *routingTableAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 5 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" || idParts[4] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing routing table association",
+			fmt.Sprintf("Expected import identifier with format: [organization_id],[region],[network_area_id],[project_id],[network_id]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	organizationId := idParts[0]
+	region := idParts[1]
+	networkAreaId := idParts[2]
+	projectId := idParts[3]
+	networkId := idParts[4]
+	ctx = tflog.SetField(ctx, "organization_id", organizationId)
+	ctx = tflog.SetField(ctx, "region", region)
+	ctx = tflog.SetField(ctx, "network_area_id", networkAreaId)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "network_id", networkId)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_area_id"), networkAreaId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkId)...)
+	tflog.Info(ctx, "Routing table association state imported")
+}
+
+// attachRoutingTable sets the routing table of the network and returns the updated network.
+func (r *routingTableAssociationResource) attachRoutingTable(ctx context.Context, projectId, region, networkId, routingTableId string) (*iaasalpha.Network, error) {
+	payload := toUpdatePayload(routingTableId)
+	err := r.client.PartialUpdateNetwork(ctx, projectId, region, networkId).PartialUpdateNetworkPayload(*payload).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return r.client.GetNetworkExecute(ctx, projectId, region, networkId)
+}
+
+func mapFields(network *iaasalpha.Network, model *Model, region string) error {
+	if network == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var networkId string
+	if model.NetworkId.ValueString() != "" {
+		networkId = model.NetworkId.ValueString()
+	} else if network.Id != nil {
+		networkId = *network.Id
+	} else {
+		return fmt.Errorf("network id not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.OrganizationId.ValueString(), region, model.NetworkAreaId.ValueString(), model.ProjectId.ValueString(), networkId)
+	model.NetworkId = types.StringValue(networkId)
+	model.RoutingTableId = types.StringPointerValue(network.RoutingTableId)
+	model.Region = types.StringValue(region)
+	return nil
+}
+
+func toUpdatePayload(routingTableId string) *iaasalpha.PartialUpdateNetworkPayload {
+	return &iaasalpha.PartialUpdateNetworkPayload{
+		RoutingTableId: &routingTableId,
+	}
+}
+
+func getDefaultRoutingTableId(routingTables *iaasalpha.RoutingTableListResponse) (string, error) {
+	if routingTables == nil {
+		return "", fmt.Errorf("routing table list response is nil")
+	}
+	for _, routingTable := range routingTables.GetItems() {
+		if routingTable.GetDefault() && routingTable.Id != nil {
+			return *routingTable.Id, nil
+		}
+	}
+	return "", fmt.Errorf("network area has no default routing table")
+}
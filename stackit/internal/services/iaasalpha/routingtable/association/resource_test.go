@@ -0,0 +1,189 @@
+package association
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/iaasalpha"
+)
+
+func TestMapFields(t *testing.T) {
+	const testRegion = "eu01"
+	id := fmt.Sprintf("%s,%s,%s,%s,%s", "oid", testRegion, "aid", "pid", "nid")
+	tests := []struct {
+		description string
+		state       Model
+		input       *iaasalpha.Network
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"values_ok",
+			Model{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				ProjectId:      types.StringValue("pid"),
+				NetworkId:      types.StringValue("nid"),
+				RoutingTableId: types.StringValue("rtid"),
+			},
+			&iaasalpha.Network{
+				Id:             utils.Ptr("nid"),
+				RoutingTableId: utils.Ptr("rtid"),
+			},
+			Model{
+				Id:             types.StringValue(id),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				ProjectId:      types.StringValue("pid"),
+				NetworkId:      types.StringValue("nid"),
+				RoutingTableId: types.StringValue("rtid"),
+				Region:         types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"routing_table_drift",
+			Model{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				ProjectId:      types.StringValue("pid"),
+				NetworkId:      types.StringValue("nid"),
+				RoutingTableId: types.StringValue("rtid"),
+			},
+			&iaasalpha.Network{
+				Id:             utils.Ptr("nid"),
+				RoutingTableId: utils.Ptr("rtid-2"),
+			},
+			Model{
+				Id:             types.StringValue(id),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				ProjectId:      types.StringValue("pid"),
+				NetworkId:      types.StringValue("nid"),
+				RoutingTableId: types.StringValue("rtid-2"),
+				Region:         types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"network_id_from_response",
+			Model{
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				ProjectId:      types.StringValue("pid"),
+			},
+			&iaasalpha.Network{
+				Id: utils.Ptr("nid"),
+			},
+			Model{
+				Id:             types.StringValue(id),
+				OrganizationId: types.StringValue("oid"),
+				NetworkAreaId:  types.StringValue("aid"),
+				ProjectId:      types.StringValue("pid"),
+				NetworkId:      types.StringValue("nid"),
+				RoutingTableId: types.StringNull(),
+				Region:         types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			Model{},
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_network_id",
+			Model{
+				ProjectId: types.StringValue("pid"),
+			},
+			&iaasalpha.Network{},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, &tt.state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToUpdatePayload(t *testing.T) {
+	output := toUpdatePayload("rtid")
+	expected := &iaasalpha.PartialUpdateNetworkPayload{
+		RoutingTableId: utils.Ptr("rtid"),
+	}
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestGetDefaultRoutingTableId(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *iaasalpha.RoutingTableListResponse
+		expected    string
+		isValid     bool
+	}{
+		{
+			"default_found",
+			&iaasalpha.RoutingTableListResponse{
+				Items: &[]iaasalpha.RoutingTable{
+					{Id: utils.Ptr("rtid-1"), Default: utils.Ptr(false)},
+					{Id: utils.Ptr("rtid-2"), Default: utils.Ptr(true)},
+					{Id: utils.Ptr("rtid-3")},
+				},
+			},
+			"rtid-2",
+			true,
+		},
+		{
+			"no_default",
+			&iaasalpha.RoutingTableListResponse{
+				Items: &[]iaasalpha.RoutingTable{
+					{Id: utils.Ptr("rtid-1"), Default: utils.Ptr(false)},
+				},
+			},
+			"",
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := getDefaultRoutingTableId(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
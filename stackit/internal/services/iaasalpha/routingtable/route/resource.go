@@ -7,6 +7,7 @@ import (
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/shared"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,9 +29,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &routeResource{}
-	_ resource.ResourceWithConfigure   = &routeResource{}
-	_ resource.ResourceWithImportState = &routeResource{}
+	_ resource.Resource                   = &routeResource{}
+	_ resource.ResourceWithConfigure      = &routeResource{}
+	_ resource.ResourceWithImportState    = &routeResource{}
+	_ resource.ResourceWithValidateConfig = &routeResource{}
 )
 
 // NewRoutingTableRouteResource is a helper function to simplify the provider implementation.
@@ -133,14 +135,17 @@ func (r *routeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
-						Description: fmt.Sprintf("CIDRV type. %s %s", utils.FormatPossibleValues("cidrv4", "cidrv6"), "Only `cidrv4` is supported during experimental stage."),
+						Description: fmt.Sprintf("CIDRV type. %s %s", utils.FormatPossibleValues(shared.DestinationTypeOptions...), "Only `cidrv4` is supported during experimental stage."),
 						Required:    true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(shared.DestinationTypeOptions...),
+						},
 					},
 					"value": schema.StringAttribute{
-						Description: "An CIDR string.",
+						Description: "An CIDR string. Must be an IPv4 CIDR for `cidrv4` and an IPv6 CIDR for `cidrv6`.",
 						Required:    true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
@@ -170,14 +175,17 @@ func (r *routeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
-						Description: fmt.Sprintf("%s %s.", utils.FormatPossibleValues("blackhole", "internet", "ipv4", "ipv6"), "Only `cidrv4` is supported during experimental stage."),
+						Description: fmt.Sprintf("%s %s", utils.FormatPossibleValues(shared.NextHopTypeOptions...), "The address family of `ipv4` and `ipv6` must match the destination type. Only `ipv4` is supported during experimental stage."),
 						Required:    true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
 						},
+						Validators: []validator.String{
+							stringvalidator.OneOf(shared.NextHopTypeOptions...),
+						},
 					},
 					"value": schema.StringAttribute{
-						Description: "Either IPv4 or IPv6 (not set for blackhole and internet). Only IPv4 supported during experimental stage.",
+						Description: "Either IPv4 or IPv6 address, matching the type. Must not be set for `blackhole` and `internet`. Only IPv4 supported during experimental stage.",
 						Optional:    true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
@@ -197,6 +205,38 @@ func (r *routeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+// ValidateConfig validates the resource configuration
+func (r *routeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model shared.RouteModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var destination *shared.RouteDestination
+	if !utils.IsUndefined(model.Destination) {
+		destination = &shared.RouteDestination{}
+		resp.Diagnostics.Append(model.Destination.As(ctx, destination, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := shared.ValidateRouteDestination(destination); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("destination"), "Invalid route destination", err.Error())
+		}
+	}
+
+	if !utils.IsUndefined(model.NextHop) {
+		nextHop := &shared.RouteNextHop{}
+		resp.Diagnostics.Append(model.NextHop.As(ctx, nextHop, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := shared.ValidateRouteNextHop(nextHop, destination); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("next_hop"), "Invalid route next hop", err.Error())
+		}
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model shared.RouteModel
@@ -480,14 +520,14 @@ func toNextHopPayload(ctx context.Context, model *shared.RouteReadModel) (*iaasa
 	}
 
 	switch nexthopModel.Type.ValueString() {
-	case "blackhole":
-		return sdkUtils.Ptr(iaasalpha.NexthopBlackholeAsRouteNexthop(iaasalpha.NewNexthopBlackhole("blackhole"))), nil
-	case "internet":
-		return sdkUtils.Ptr(iaasalpha.NexthopInternetAsRouteNexthop(iaasalpha.NewNexthopInternet("internet"))), nil
-	case "ipv4":
-		return sdkUtils.Ptr(iaasalpha.NexthopIPv4AsRouteNexthop(iaasalpha.NewNexthopIPv4("ipv4", nexthopModel.Value.ValueString()))), nil
-	case "ipv6":
-		return sdkUtils.Ptr(iaasalpha.NexthopIPv6AsRouteNexthop(iaasalpha.NewNexthopIPv6("ipv6", nexthopModel.Value.ValueString()))), nil
+	case shared.NextHopTypeBlackhole:
+		return sdkUtils.Ptr(iaasalpha.NexthopBlackholeAsRouteNexthop(iaasalpha.NewNexthopBlackhole(shared.NextHopTypeBlackhole))), nil
+	case shared.NextHopTypeInternet:
+		return sdkUtils.Ptr(iaasalpha.NexthopInternetAsRouteNexthop(iaasalpha.NewNexthopInternet(shared.NextHopTypeInternet))), nil
+	case shared.NextHopTypeIPv4:
+		return sdkUtils.Ptr(iaasalpha.NexthopIPv4AsRouteNexthop(iaasalpha.NewNexthopIPv4(shared.NextHopTypeIPv4, nexthopModel.Value.ValueString()))), nil
+	case shared.NextHopTypeIPv6:
+		return sdkUtils.Ptr(iaasalpha.NexthopIPv6AsRouteNexthop(iaasalpha.NewNexthopIPv6(shared.NextHopTypeIPv6, nexthopModel.Value.ValueString()))), nil
 	}
 	return nil, fmt.Errorf("unknown nexthop type: %s", nexthopModel.Type.ValueString())
 }
@@ -507,10 +547,10 @@ func toDestinationPayload(ctx context.Context, model *shared.RouteReadModel) (*i
 	}
 
 	switch destinationModel.Type.ValueString() {
-	case "cidrv4":
-		return sdkUtils.Ptr(iaasalpha.DestinationCIDRv4AsRouteDestination(iaasalpha.NewDestinationCIDRv4("cidrv4", destinationModel.Value.ValueString()))), nil
-	case "cidrv6":
-		return sdkUtils.Ptr(iaasalpha.DestinationCIDRv6AsRouteDestination(iaasalpha.NewDestinationCIDRv6("cidrv6", destinationModel.Value.ValueString()))), nil
+	case shared.DestinationTypeCIDRv4:
+		return sdkUtils.Ptr(iaasalpha.DestinationCIDRv4AsRouteDestination(iaasalpha.NewDestinationCIDRv4(shared.DestinationTypeCIDRv4, destinationModel.Value.ValueString()))), nil
+	case shared.DestinationTypeCIDRv6:
+		return sdkUtils.Ptr(iaasalpha.DestinationCIDRv6AsRouteDestination(iaasalpha.NewDestinationCIDRv6(shared.DestinationTypeCIDRv6, destinationModel.Value.ValueString()))), nil
 	}
 	return nil, fmt.Errorf("unknown destination type: %s", destinationModel.Type.ValueString())
 }
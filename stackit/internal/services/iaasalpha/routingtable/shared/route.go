@@ -3,6 +3,7 @@ package shared
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"strings"
 	"time"
 
@@ -52,6 +53,21 @@ func RouteModelTypes() map[string]attr.Type {
 	return modelTypes
 }
 
+const (
+	DestinationTypeCIDRv4 = "cidrv4"
+	DestinationTypeCIDRv6 = "cidrv6"
+
+	NextHopTypeBlackhole = "blackhole"
+	NextHopTypeInternet  = "internet"
+	NextHopTypeIPv4      = "ipv4"
+	NextHopTypeIPv6      = "ipv6"
+)
+
+var (
+	DestinationTypeOptions = []string{DestinationTypeCIDRv4, DestinationTypeCIDRv6}
+	NextHopTypeOptions     = []string{NextHopTypeBlackhole, NextHopTypeInternet, NextHopTypeIPv4, NextHopTypeIPv6}
+)
+
 // RouteDestination is the struct corresponding to RouteReadModel.Destination
 type RouteDestination struct {
 	Type  types.String `tfsdk:"type"`
@@ -211,3 +227,78 @@ func MapRouteDestination(routeResp *iaasalpha.Route) (types.Object, error) {
 
 	return destinationTF, nil
 }
+
+// ValidateRouteDestination checks that the destination value is a CIDR of the family given by the destination type.
+// Unknown values are not validated.
+func ValidateRouteDestination(destination *RouteDestination) error {
+	if destination == nil || destination.Type.IsUnknown() || destination.Value.IsUnknown() {
+		return nil
+	}
+	destinationType := destination.Type.ValueString()
+	prefix, err := netip.ParsePrefix(destination.Value.ValueString())
+	if err != nil {
+		return fmt.Errorf("destination value %q is not a valid CIDR", destination.Value.ValueString())
+	}
+	switch destinationType {
+	case DestinationTypeCIDRv4:
+		if !prefix.Addr().Is4() {
+			return fmt.Errorf("destination of type %q requires an IPv4 CIDR, got %q", destinationType, destination.Value.ValueString())
+		}
+	case DestinationTypeCIDRv6:
+		if !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+			return fmt.Errorf("destination of type %q requires an IPv6 CIDR, got %q", destinationType, destination.Value.ValueString())
+		}
+	default:
+		return fmt.Errorf("unknown destination type: %s", destinationType)
+	}
+	return nil
+}
+
+// ValidateRouteNextHop checks that the next hop value matches the next hop type: "ipv4" and "ipv6" require an
+// address of the respective family, "blackhole" and "internet" must not have a value. If a destination is given,
+// the address family of an "ipv4" or "ipv6" next hop must match the destination type. Unknown values are not validated.
+func ValidateRouteNextHop(nextHop *RouteNextHop, destination *RouteDestination) error {
+	if nextHop == nil || nextHop.Type.IsUnknown() || nextHop.Value.IsUnknown() {
+		return nil
+	}
+	nextHopType := nextHop.Type.ValueString()
+	switch nextHopType {
+	case NextHopTypeBlackhole, NextHopTypeInternet:
+		if !nextHop.Value.IsNull() {
+			return fmt.Errorf("next hop of type %q must not have a value", nextHopType)
+		}
+		return nil
+	case NextHopTypeIPv4, NextHopTypeIPv6:
+		if nextHop.Value.IsNull() {
+			return fmt.Errorf("next hop of type %q requires a value", nextHopType)
+		}
+	default:
+		return fmt.Errorf("unknown next hop type: %s", nextHopType)
+	}
+
+	addr, err := netip.ParseAddr(nextHop.Value.ValueString())
+	if err != nil {
+		return fmt.Errorf("next hop value %q is not a valid IP address", nextHop.Value.ValueString())
+	}
+	if nextHopType == NextHopTypeIPv4 && !addr.Is4() {
+		return fmt.Errorf("next hop of type %q requires an IPv4 address, got %q", nextHopType, nextHop.Value.ValueString())
+	}
+	if nextHopType == NextHopTypeIPv6 && (!addr.Is6() || addr.Is4In6()) {
+		return fmt.Errorf("next hop of type %q requires an IPv6 address, got %q", nextHopType, nextHop.Value.ValueString())
+	}
+
+	if destination == nil || destination.Type.IsUnknown() {
+		return nil
+	}
+	switch destination.Type.ValueString() {
+	case DestinationTypeCIDRv4:
+		if nextHopType != NextHopTypeIPv4 {
+			return fmt.Errorf("next hop of type %q cannot be used for a destination of type %q", nextHopType, DestinationTypeCIDRv4)
+		}
+	case DestinationTypeCIDRv6:
+		if nextHopType != NextHopTypeIPv6 {
+			return fmt.Errorf("next hop of type %q cannot be used for a destination of type %q", nextHopType, DestinationTypeCIDRv6)
+		}
+	}
+	return nil
+}
@@ -308,3 +308,61 @@ func TestMapRouteModel(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRouteDestination(t *testing.T) {
+	tests := []struct {
+		name    string
+		dest    *RouteDestination
+		wantErr bool
+	}{
+		{"nil", nil, false},
+		{"cidrv4", &RouteDestination{Type: types.StringValue("cidrv4"), Value: types.StringValue("10.0.0.0/24")}, false},
+		{"cidrv6", &RouteDestination{Type: types.StringValue("cidrv6"), Value: types.StringValue("2001:db8::/32")}, false},
+		{"unknown value", &RouteDestination{Type: types.StringValue("cidrv4"), Value: types.StringUnknown()}, false},
+		{"cidrv4 with ipv6 value", &RouteDestination{Type: types.StringValue("cidrv4"), Value: types.StringValue("2001:db8::/32")}, true},
+		{"cidrv6 with ipv4 value", &RouteDestination{Type: types.StringValue("cidrv6"), Value: types.StringValue("10.0.0.0/24")}, true},
+		{"no cidr", &RouteDestination{Type: types.StringValue("cidrv4"), Value: types.StringValue("10.0.0.1")}, true},
+		{"invalid type", &RouteDestination{Type: types.StringValue("foo"), Value: types.StringValue("10.0.0.0/24")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateRouteDestination(tt.dest); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRouteDestination() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRouteNextHop(t *testing.T) {
+	destinationV4 := &RouteDestination{Type: types.StringValue("cidrv4"), Value: types.StringValue("10.0.0.0/24")}
+	destinationV6 := &RouteDestination{Type: types.StringValue("cidrv6"), Value: types.StringValue("2001:db8::/32")}
+	tests := []struct {
+		name        string
+		nextHop     *RouteNextHop
+		destination *RouteDestination
+		wantErr     bool
+	}{
+		{"nil", nil, nil, false},
+		{"ipv4", &RouteNextHop{Type: types.StringValue("ipv4"), Value: types.StringValue("10.1.0.1")}, destinationV4, false},
+		{"ipv6", &RouteNextHop{Type: types.StringValue("ipv6"), Value: types.StringValue("fd00::1")}, destinationV6, false},
+		{"ipv4 without destination", &RouteNextHop{Type: types.StringValue("ipv4"), Value: types.StringValue("10.1.0.1")}, nil, false},
+		{"blackhole", &RouteNextHop{Type: types.StringValue("blackhole"), Value: types.StringNull()}, destinationV4, false},
+		{"internet", &RouteNextHop{Type: types.StringValue("internet"), Value: types.StringNull()}, destinationV6, false},
+		{"unknown value", &RouteNextHop{Type: types.StringValue("ipv4"), Value: types.StringUnknown()}, destinationV4, false},
+		{"blackhole with value", &RouteNextHop{Type: types.StringValue("blackhole"), Value: types.StringValue("10.1.0.1")}, destinationV4, true},
+		{"ipv4 without value", &RouteNextHop{Type: types.StringValue("ipv4"), Value: types.StringNull()}, destinationV4, true},
+		{"ipv4 with ipv6 value", &RouteNextHop{Type: types.StringValue("ipv4"), Value: types.StringValue("fd00::1")}, nil, true},
+		{"ipv6 with ipv4 value", &RouteNextHop{Type: types.StringValue("ipv6"), Value: types.StringValue("10.1.0.1")}, nil, true},
+		{"invalid address", &RouteNextHop{Type: types.StringValue("ipv4"), Value: types.StringValue("foo")}, nil, true},
+		{"ipv4 for cidrv6 destination", &RouteNextHop{Type: types.StringValue("ipv4"), Value: types.StringValue("10.1.0.1")}, destinationV6, true},
+		{"ipv6 for cidrv4 destination", &RouteNextHop{Type: types.StringValue("ipv6"), Value: types.StringValue("fd00::1")}, destinationV4, true},
+		{"invalid type", &RouteNextHop{Type: types.StringValue("foo"), Value: types.StringNull()}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateRouteNextHop(tt.nextHop, tt.destination); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRouteNextHop() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -653,6 +653,9 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 									"ip": schema.StringAttribute{
 										Description: descriptions["ip"],
 										Required:    true,
+										Validators: []validator.String{
+											validate.IP(false),
+										},
 									},
 								},
 							},
@@ -0,0 +1,410 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+	"golang.org/x/mod/semver"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &clustersDataSource{}
+)
+
+// ClustersModel is the model of the SKE clusters data source
+type ClustersModel struct {
+	Id                types.String `tfsdk:"id"` // needed by TF
+	ProjectId         types.String `tfsdk:"project_id"`
+	Region            types.String `tfsdk:"region"`
+	NameRegex         types.String `tfsdk:"name_regex"`
+	KubernetesVersion types.String `tfsdk:"kubernetes_version"`
+	Status            types.String `tfsdk:"status"`
+	NodePoolLabels    types.Map    `tfsdk:"node_pool_labels"`
+	Clusters          types.List   `tfsdk:"clusters"`
+}
+
+// Types corresponding to ClustersModel.Clusters[i]
+var clusterTypes = map[string]attr.Type{
+	"name":                    types.StringType,
+	"kubernetes_version_used": types.StringType,
+	"status":                  types.StringType,
+	"hibernated":              types.BoolType,
+	"creation_time":           types.StringType,
+	"network_id":              types.StringType,
+	"node_pool_names":         types.ListType{ElemType: types.StringType},
+}
+
+// clustersFilter holds the parsed filter attributes of the data source
+type clustersFilter struct {
+	nameRegex         *regexp.Regexp
+	kubernetesVersion string
+	status            string
+	nodePoolLabels    map[string]string
+}
+
+// NewClustersDataSource is a helper function to simplify the provider implementation.
+func NewClustersDataSource() datasource.DataSource {
+	return &clustersDataSource{}
+}
+
+// clustersDataSource is the data source implementation.
+type clustersDataSource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *clustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_clusters"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *clustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *clustersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	statusOptions := []string{}
+	for _, v := range ske.AllowedClusterStatusStateEnumValues {
+		statusOptions = append(statusOptions, string(v))
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "SKE Clusters data source schema. Lists the clusters of a project, optionally filtered. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`,`region`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the clusters are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				// the region cannot be found, so it has to be passed
+				Optional:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression (RE2 syntax) the cluster name must match.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"kubernetes_version": schema.StringAttribute{
+				Description: "Kubernetes version the clusters must use. If only the minor version is set (e.g. `1.31`), all patch versions of it match.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.VersionNumber(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Aggregated status the clusters must have. " + utils.FormatPossibleValues(statusOptions...),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(statusOptions...),
+				},
+			},
+			"node_pool_labels": schema.MapAttribute{
+				Description: "Labels that at least one node pool of the cluster must have.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"clusters": schema.ListNestedAttribute{
+				Description: "List of clusters matching all filters, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The cluster name.",
+							Computed:    true,
+						},
+						"kubernetes_version_used": schema.StringAttribute{
+							Description: "Full Kubernetes version used.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Aggregated status of the cluster.",
+							Computed:    true,
+						},
+						"hibernated": schema.BoolAttribute{
+							Description: "Whether the cluster is hibernated.",
+							Computed:    true,
+						},
+						"creation_time": schema.StringAttribute{
+							Description: "Date-time when the cluster was created.",
+							Computed:    true,
+						},
+						"network_id": schema.StringAttribute{
+							Description: "ID of the STACKIT Network Area (SNA) network the cluster is deployed in.",
+							Computed:    true,
+						},
+						"node_pool_names": schema.ListAttribute{
+							Description: "Names of the node pools of the cluster.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model ClustersModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	filter, err := toClustersFilter(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading clusters", fmt.Sprintf("Invalid filter: %v", err))
+		return
+	}
+
+	clustersResp, err := d.client.ListClusters(ctx, projectId, region).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading clusters",
+			fmt.Sprintf("Clusters could not be listed in project %q.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapClustersFields(ctx, clustersResp, filter, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading clusters", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE clusters read")
+}
+
+func toClustersFilter(ctx context.Context, model *ClustersModel) (*clustersFilter, error) {
+	filter := &clustersFilter{
+		kubernetesVersion: model.KubernetesVersion.ValueString(),
+		status:            model.Status.ValueString(),
+	}
+
+	if nameRegex := model.NameRegex.ValueString(); nameRegex != "" {
+		compiledRegex, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("compiling name_regex: %w", err)
+		}
+		filter.nameRegex = compiledRegex
+	}
+
+	if !model.NodePoolLabels.IsNull() && !model.NodePoolLabels.IsUnknown() {
+		labels := map[string]string{}
+		diags := model.NodePoolLabels.ElementsAs(ctx, &labels, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting node_pool_labels: %w", core.DiagsToError(diags))
+		}
+		filter.nodePoolLabels = labels
+	}
+	return filter, nil
+}
+
+// matches checks if the cluster fulfills all filters
+func (f *clustersFilter) matches(cl *ske.Cluster) bool {
+	if f.nameRegex != nil && (cl.Name == nil || !f.nameRegex.MatchString(*cl.Name)) {
+		return false
+	}
+	if f.kubernetesVersion != "" {
+		if cl.Kubernetes == nil || cl.Kubernetes.Version == nil || !kubernetesVersionMatches(*cl.Kubernetes.Version, f.kubernetesVersion) {
+			return false
+		}
+	}
+	if f.status != "" {
+		if cl.Status == nil || cl.Status.Aggregated == nil || string(*cl.Status.Aggregated) != f.status {
+			return false
+		}
+	}
+	if len(f.nodePoolLabels) > 0 && !hasNodePoolWithLabels(cl, f.nodePoolLabels) {
+		return false
+	}
+	return true
+}
+
+// kubernetesVersionMatches checks if the version matches the filter version.
+// A filter with only [MAJOR].[MINOR] matches every patch version of that minor version.
+func kubernetesVersionMatches(version, filterVersion string) bool {
+	versionPrefixed := "v" + strings.TrimPrefix(version, "v")
+	filterPrefixed := "v" + strings.TrimPrefix(filterVersion, "v")
+	if !semver.IsValid(versionPrefixed) || !semver.IsValid(filterPrefixed) {
+		return version == filterVersion
+	}
+	if strings.Count(filterPrefixed, ".") == 1 {
+		return semver.MajorMinor(versionPrefixed) == semver.MajorMinor(filterPrefixed)
+	}
+	return semver.Compare(versionPrefixed, filterPrefixed) == 0
+}
+
+func hasNodePoolWithLabels(cl *ske.Cluster, labels map[string]string) bool {
+	if cl.Nodepools == nil {
+		return false
+	}
+	for _, nodePool := range *cl.Nodepools {
+		if nodePool.Labels == nil {
+			continue
+		}
+		matchesAll := true
+		for k, v := range labels {
+			if value, ok := (*nodePool.Labels)[k]; !ok || value != v {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			return true
+		}
+	}
+	return false
+}
+
+func mapClustersFields(ctx context.Context, clustersResp *ske.ListClustersResponse, filter *clustersFilter, model *ClustersModel, region string) error {
+	if clustersResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if filter == nil {
+		filter = &clustersFilter{}
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region)
+	model.Region = types.StringValue(region)
+
+	matchedClusters := []ske.Cluster{}
+	if clustersResp.Items != nil {
+		for i := range *clustersResp.Items {
+			if filter.matches(&(*clustersResp.Items)[i]) {
+				matchedClusters = append(matchedClusters, (*clustersResp.Items)[i])
+			}
+		}
+	}
+	sort.SliceStable(matchedClusters, func(i, j int) bool {
+		return matchedClusters[i].GetName() < matchedClusters[j].GetName()
+	})
+
+	clusters := []attr.Value{}
+	for i := range matchedClusters {
+		cl := &matchedClusters[i]
+
+		kubernetesVersion := types.StringNull()
+		if cl.Kubernetes != nil {
+			kubernetesVersion = types.StringPointerValue(cl.Kubernetes.Version)
+		}
+
+		status := types.StringNull()
+		hibernated := types.BoolNull()
+		creationTime := types.StringNull()
+		if cl.Status != nil {
+			if cl.Status.Aggregated != nil {
+				status = types.StringValue(string(*cl.Status.Aggregated))
+			}
+			hibernated = types.BoolPointerValue(cl.Status.Hibernated)
+			if cl.Status.CreationTime != nil {
+				creationTime = types.StringValue(cl.Status.CreationTime.Format(time.RFC3339))
+			}
+		}
+
+		networkId := types.StringNull()
+		if cl.Network != nil {
+			networkId = types.StringPointerValue(cl.Network.Id)
+		}
+
+		nodePoolNames := []string{}
+		if cl.Nodepools != nil {
+			for _, nodePool := range *cl.Nodepools {
+				if nodePool.Name != nil {
+					nodePoolNames = append(nodePoolNames, *nodePool.Name)
+				}
+			}
+		}
+		nodePoolNamesTF, diags := types.ListValueFrom(ctx, types.StringType, nodePoolNames)
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d, field node_pool_names: %w", i, core.DiagsToError(diags))
+		}
+
+		clusterTF, diags := types.ObjectValue(clusterTypes, map[string]attr.Value{
+			"name":                    types.StringPointerValue(cl.Name),
+			"kubernetes_version_used": kubernetesVersion,
+			"status":                  status,
+			"hibernated":              hibernated,
+			"creation_time":           creationTime,
+			"network_id":              networkId,
+			"node_pool_names":         nodePoolNamesTF,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		clusters = append(clusters, clusterTF)
+	}
+
+	clustersTF, diags := types.ListValue(types.ObjectType{AttrTypes: clusterTypes}, clusters)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Clusters = clustersTF
+	return nil
+}
@@ -0,0 +1,245 @@
+package ske
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestMapClustersFields(t *testing.T) {
+	creationTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	clusters := []ske.Cluster{
+		{
+			Name:       utils.Ptr("prod"),
+			Kubernetes: &ske.Kubernetes{Version: utils.Ptr("1.31.4")},
+			Network:    &ske.Network{Id: utils.Ptr("nid")},
+			Nodepools: &[]ske.Nodepool{
+				{
+					Name:   utils.Ptr("pool-a"),
+					Labels: &map[string]string{"team": "a", "env": "prod"},
+				},
+				{
+					Name: utils.Ptr("pool-b"),
+				},
+			},
+			Status: &ske.ClusterStatus{
+				Aggregated:   utils.Ptr(ske.CLUSTERSTATUSSTATE_HEALTHY),
+				CreationTime: &creationTime,
+				Hibernated:   utils.Ptr(false),
+			},
+		},
+		{
+			Name:       utils.Ptr("dev"),
+			Kubernetes: &ske.Kubernetes{Version: utils.Ptr("1.30.8")},
+			Nodepools: &[]ske.Nodepool{
+				{
+					Name:   utils.Ptr("pool-a"),
+					Labels: &map[string]string{"team": "a"},
+				},
+			},
+			Status: &ske.ClusterStatus{
+				Aggregated: utils.Ptr(ske.CLUSTERSTATUSSTATE_HIBERNATED),
+				Hibernated: utils.Ptr(true),
+			},
+		},
+	}
+	prodCluster := types.ObjectValueMust(clusterTypes, map[string]attr.Value{
+		"name":                    types.StringValue("prod"),
+		"kubernetes_version_used": types.StringValue("1.31.4"),
+		"status":                  types.StringValue(string(ske.CLUSTERSTATUSSTATE_HEALTHY)),
+		"hibernated":              types.BoolValue(false),
+		"creation_time":           types.StringValue("2025-01-02T03:04:05Z"),
+		"network_id":              types.StringValue("nid"),
+		"node_pool_names": types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("pool-a"),
+			types.StringValue("pool-b"),
+		}),
+	})
+	devCluster := types.ObjectValueMust(clusterTypes, map[string]attr.Value{
+		"name":                    types.StringValue("dev"),
+		"kubernetes_version_used": types.StringValue("1.30.8"),
+		"status":                  types.StringValue(string(ske.CLUSTERSTATUSSTATE_HIBERNATED)),
+		"hibernated":              types.BoolValue(true),
+		"creation_time":           types.StringNull(),
+		"network_id":              types.StringNull(),
+		"node_pool_names": types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("pool-a"),
+		}),
+	})
+
+	tests := []struct {
+		description string
+		input       *ske.ListClustersResponse
+		filter      *clustersFilter
+		expected    []attr.Value
+		isValid     bool
+	}{
+		{
+			"no_filter",
+			&ske.ListClustersResponse{Items: &clusters},
+			&clustersFilter{},
+			[]attr.Value{devCluster, prodCluster},
+			true,
+		},
+		{
+			"empty_response",
+			&ske.ListClustersResponse{},
+			&clustersFilter{},
+			[]attr.Value{},
+			true,
+		},
+		{
+			"name_regex",
+			&ske.ListClustersResponse{Items: &clusters},
+			&clustersFilter{nameRegex: regexp.MustCompile("^pr")},
+			[]attr.Value{prodCluster},
+			true,
+		},
+		{
+			"kubernetes_minor_version",
+			&ske.ListClustersResponse{Items: &clusters},
+			&clustersFilter{kubernetesVersion: "1.30"},
+			[]attr.Value{devCluster},
+			true,
+		},
+		{
+			"kubernetes_patch_version_no_match",
+			&ske.ListClustersResponse{Items: &clusters},
+			&clustersFilter{kubernetesVersion: "1.31.5"},
+			[]attr.Value{},
+			true,
+		},
+		{
+			"status",
+			&ske.ListClustersResponse{Items: &clusters},
+			&clustersFilter{status: string(ske.CLUSTERSTATUSSTATE_HEALTHY)},
+			[]attr.Value{prodCluster},
+			true,
+		},
+		{
+			"node_pool_labels",
+			&ske.ListClustersResponse{Items: &clusters},
+			&clustersFilter{nodePoolLabels: map[string]string{"team": "a", "env": "prod"}},
+			[]attr.Value{prodCluster},
+			true,
+		},
+		{
+			"all_filters",
+			&ske.ListClustersResponse{Items: &clusters},
+			&clustersFilter{
+				nameRegex:         regexp.MustCompile("dev"),
+				kubernetesVersion: "1.30.8",
+				status:            string(ske.CLUSTERSTATUSSTATE_HIBERNATED),
+				nodePoolLabels:    map[string]string{"team": "a"},
+			},
+			[]attr.Value{devCluster},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			&clustersFilter{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &ClustersModel{
+				ProjectId:      types.StringValue("pid"),
+				NodePoolLabels: types.MapNull(types.StringType),
+			}
+			err := mapClustersFields(context.Background(), tt.input, tt.filter, state, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				expected := ClustersModel{
+					Id:             types.StringValue("pid,region"),
+					ProjectId:      types.StringValue("pid"),
+					Region:         types.StringValue(testRegion),
+					NodePoolLabels: types.MapNull(types.StringType),
+					Clusters:       types.ListValueMust(types.ObjectType{AttrTypes: clusterTypes}, tt.expected),
+				}
+				diff := cmp.Diff(state, &expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestKubernetesVersionMatches(t *testing.T) {
+	tests := []struct {
+		description   string
+		version       string
+		filterVersion string
+		expected      bool
+	}{
+		{"exact_match", "1.31.4", "1.31.4", true},
+		{"patch_mismatch", "1.31.4", "1.31.5", false},
+		{"minor_match", "1.31.4", "1.31", true},
+		{"minor_mismatch", "1.30.4", "1.31", false},
+		{"minor_not_prefix", "1.310.1", "1.31", false},
+		{"invalid_version", "latest", "latest", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got := kubernetesVersionMatches(tt.version, tt.filterVersion)
+			if got != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestToClustersFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ClustersModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&ClustersModel{},
+			true,
+		},
+		{
+			"valid_filters",
+			&ClustersModel{
+				NameRegex:      types.StringValue("^prod-.*$"),
+				NodePoolLabels: types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("a")}),
+			},
+			true,
+		},
+		{
+			"invalid_regex",
+			&ClustersModel{
+				NameRegex: types.StringValue("prod-("),
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := toClustersFilter(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
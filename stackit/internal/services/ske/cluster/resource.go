@@ -314,6 +314,9 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 
 // Schema defines the schema for the resource.
 func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	criOptions := []string{string(ske.CRINAME_CONTAINERD), string(ske.CRINAME_DOCKER)}
+	taintEffectOptions := []string{string(ske.TAINTEFFECT_NO_SCHEDULE), string(ske.TAINTEFFECT_PREFER_NO_SCHEDULE), string(ske.TAINTEFFECT_NO_EXECUTE)}
+
 	descriptions := map[string]string{
 		"main": "SKE Cluster Resource schema. Must have a `region` specified in the provider configuration.",
 		"node_pools_plan_note": "When updating `node_pools` of a `stackit_ske_cluster`, the Terraform plan might appear incorrect as it matches the node pools by index rather than by name. " +
@@ -475,8 +478,11 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
 									"effect": schema.StringAttribute{
-										Description: "The taint effect. E.g `PreferNoSchedule`.",
+										Description: "The taint effect. " + utils.FormatPossibleValues(taintEffectOptions...),
 										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(taintEffectOptions...),
+										},
 									},
 									"key": schema.StringAttribute{
 										Description: "Taint key to be applied to a node.",
@@ -497,10 +503,13 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							},
 						},
 						"cri": schema.StringAttribute{
-							Description: "Specifies the container runtime. Defaults to `containerd`",
+							Description: "Specifies the container runtime. Defaults to `containerd`. " + utils.FormatPossibleValues(criOptions...),
 							Optional:    true,
 							Computed:    true,
 							Default:     stringdefault.StaticString(DefaultCRI),
+							Validators: []validator.String{
+								stringvalidator.OneOf(criOptions...),
+							},
 						},
 					},
 				},
@@ -1437,31 +1446,39 @@ func mapNodePools(ctx context.Context, cl *ske.Cluster, model *Model) error {
 
 	nodePools := []attr.Value{}
 	for i, nodePoolResp := range *cl.Nodepools {
+		if nodePoolResp.Name == nil {
+			return fmt.Errorf("mapping index %d: name not present", i)
+		}
 		nodePool := map[string]attr.Value{
 			"name":                    types.StringPointerValue(nodePoolResp.Name),
-			"machine_type":            types.StringPointerValue(nodePoolResp.Machine.Type),
+			"machine_type":            types.StringNull(),
 			"os_name":                 types.StringNull(),
 			"os_version_min":          modelNodePoolOSVersionMin[*nodePoolResp.Name],
 			"os_version":              modelNodePoolOSVersion[*nodePoolResp.Name],
+			"os_version_used":         types.StringNull(),
 			"minimum":                 types.Int64PointerValue(nodePoolResp.Minimum),
 			"maximum":                 types.Int64PointerValue(nodePoolResp.Maximum),
 			"max_surge":               types.Int64PointerValue(nodePoolResp.MaxSurge),
 			"max_unavailable":         types.Int64PointerValue(nodePoolResp.MaxUnavailable),
 			"volume_type":             types.StringNull(),
-			"volume_size":             types.Int64PointerValue(nodePoolResp.Volume.Size),
+			"volume_size":             types.Int64Null(),
 			"labels":                  types.MapNull(types.StringType),
 			"cri":                     types.StringNull(),
 			"availability_zones":      types.ListNull(types.StringType),
 			"allow_system_components": types.BoolPointerValue(nodePoolResp.AllowSystemComponents),
 		}
 
-		if nodePoolResp.Machine != nil && nodePoolResp.Machine.Image != nil {
-			nodePool["os_name"] = types.StringPointerValue(nodePoolResp.Machine.Image.Name)
-			nodePool["os_version_used"] = types.StringPointerValue(nodePoolResp.Machine.Image.Version)
+		if nodePoolResp.Machine != nil {
+			nodePool["machine_type"] = types.StringPointerValue(nodePoolResp.Machine.Type)
+			if nodePoolResp.Machine.Image != nil {
+				nodePool["os_name"] = types.StringPointerValue(nodePoolResp.Machine.Image.Name)
+				nodePool["os_version_used"] = types.StringPointerValue(nodePoolResp.Machine.Image.Version)
+			}
 		}
 
 		if nodePoolResp.Volume != nil {
 			nodePool["volume_type"] = types.StringPointerValue(nodePoolResp.Volume.Type)
+			nodePool["volume_size"] = types.Int64PointerValue(nodePoolResp.Volume.Size)
 		}
 
 		if nodePoolResp.Cri != nil {
@@ -685,6 +685,78 @@ func TestMapFields(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"node_pool_without_machine_and_volume",
+			types.ObjectNull(extensionsTypes),
+			types.ListNull(types.ObjectType{AttrTypes: nodePoolTypes}),
+			&ske.Cluster{
+				Name: utils.Ptr("name"),
+				Nodepools: &[]ske.Nodepool{
+					{
+						Name:    utils.Ptr("node"),
+						Minimum: utils.Ptr(int64(1)),
+						Maximum: utils.Ptr(int64(2)),
+					},
+				},
+			},
+			testRegion,
+			Model{
+				Id:        types.StringValue("pid,region,name"),
+				ProjectId: types.StringValue("pid"),
+				Name:      types.StringValue("name"),
+				NodePools: types.ListValueMust(
+					types.ObjectType{AttrTypes: nodePoolTypes},
+					[]attr.Value{
+						types.ObjectValueMust(
+							nodePoolTypes,
+							map[string]attr.Value{
+								"name":                    types.StringValue("node"),
+								"machine_type":            types.StringNull(),
+								"os_name":                 types.StringNull(),
+								"os_version":              types.StringNull(),
+								"os_version_min":          types.StringNull(),
+								"os_version_used":         types.StringNull(),
+								"minimum":                 types.Int64Value(1),
+								"maximum":                 types.Int64Value(2),
+								"max_surge":               types.Int64Null(),
+								"max_unavailable":         types.Int64Null(),
+								"volume_type":             types.StringNull(),
+								"volume_size":             types.Int64Null(),
+								"labels":                  types.MapNull(types.StringType),
+								"taints":                  types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+								"cri":                     types.StringNull(),
+								"availability_zones":      types.ListNull(types.StringType),
+								"allow_system_components": types.BoolNull(),
+							},
+						),
+					},
+				),
+				Maintenance:         types.ObjectNull(maintenanceTypes),
+				Network:             types.ObjectNull(networkTypes),
+				Hibernations:        types.ListNull(types.ObjectType{AttrTypes: hibernationTypes}),
+				Extensions:          types.ObjectNull(extensionsTypes),
+				EgressAddressRanges: types.ListNull(types.StringType),
+				PodAddressRanges:    types.ListNull(types.StringType),
+				Region:              types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"node_pool_without_name",
+			types.ObjectNull(extensionsTypes),
+			types.ListNull(types.ObjectType{AttrTypes: nodePoolTypes}),
+			&ske.Cluster{
+				Name: utils.Ptr("name"),
+				Nodepools: &[]ske.Nodepool{
+					{
+						Minimum: utils.Ptr(int64(1)),
+					},
+				},
+			},
+			testRegion,
+			Model{},
+			false,
+		},
 		{
 			"nil_response",
 			types.ObjectNull(extensionsTypes),
@@ -2285,6 +2357,196 @@ func TestToNetworkPayload(t *testing.T) {
 	}
 }
 
+func TestToNodepoolsPayload(t *testing.T) {
+	availableMachineVersions := []ske.MachineImage{
+		{
+			Name: utils.Ptr("flatcar"),
+			Versions: &[]ske.MachineImageVersion{
+				{
+					State:   utils.Ptr(VersionStateSupported),
+					Version: utils.Ptr("3815.2.1"),
+				},
+				{
+					State:   utils.Ptr(VersionStateSupported),
+					Version: utils.Ptr("3815.2.5"),
+				},
+			},
+		},
+	}
+	nodePoolValues := func(overrides map[string]attr.Value) attr.Value {
+		values := map[string]attr.Value{
+			"name":            types.StringValue("node"),
+			"machine_type":    types.StringValue("c1.2"),
+			"os_name":         types.StringValue("flatcar"),
+			"os_version_min":  types.StringValue("3815.2"),
+			"os_version":      types.StringNull(),
+			"os_version_used": types.StringNull(),
+			"minimum":         types.Int64Value(1),
+			"maximum":         types.Int64Value(3),
+			"max_surge":       types.Int64Value(2),
+			"max_unavailable": types.Int64Value(0),
+			"volume_type":     types.StringValue("storage_premium_perf1"),
+			"volume_size":     types.Int64Value(40),
+			"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"k": types.StringValue("v"),
+			}),
+			"taints": types.ListValueMust(types.ObjectType{AttrTypes: taintTypes}, []attr.Value{
+				types.ObjectValueMust(taintTypes, map[string]attr.Value{
+					"effect": types.StringValue(string(ske.TAINTEFFECT_NO_SCHEDULE)),
+					"key":    types.StringValue("key"),
+					"value":  types.StringValue("value"),
+				}),
+			}),
+			"cri": types.StringValue(string(ske.CRINAME_CONTAINERD)),
+			"availability_zones": types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("eu01-1"),
+				types.StringValue("eu01-2"),
+			}),
+			"allow_system_components": types.BoolValue(true),
+		}
+		for k, v := range overrides {
+			values[k] = v
+		}
+		return types.ObjectValueMust(nodePoolTypes, values)
+	}
+
+	tests := []struct {
+		description     string
+		nodePools       []attr.Value
+		currentImages   map[string]*ske.Image
+		expected        []ske.Nodepool
+		expectedWarning []string
+		isValid         bool
+	}{
+		{
+			"all_fields",
+			[]attr.Value{nodePoolValues(nil)},
+			nil,
+			[]ske.Nodepool{
+				{
+					Name:           utils.Ptr("node"),
+					Minimum:        utils.Ptr(int64(1)),
+					Maximum:        utils.Ptr(int64(3)),
+					MaxSurge:       utils.Ptr(int64(2)),
+					MaxUnavailable: utils.Ptr(int64(0)),
+					Machine: &ske.Machine{
+						Type: utils.Ptr("c1.2"),
+						Image: &ske.Image{
+							Name:    utils.Ptr("flatcar"),
+							Version: utils.Ptr("3815.2.5"),
+						},
+					},
+					Volume: &ske.Volume{
+						Type: utils.Ptr("storage_premium_perf1"),
+						Size: utils.Ptr(int64(40)),
+					},
+					Taints: &[]ske.Taint{
+						{
+							Effect: ske.TAINTEFFECT_NO_SCHEDULE.Ptr(),
+							Key:    utils.Ptr("key"),
+							Value:  utils.Ptr("value"),
+						},
+					},
+					Cri: &ske.CRI{
+						Name: ske.CRINAME_CONTAINERD.Ptr(),
+					},
+					Labels:                &map[string]string{"k": "v"},
+					AvailabilityZones:     &[]string{"eu01-1", "eu01-2"},
+					AllowSystemComponents: utils.Ptr(true),
+				},
+			},
+			[]string{},
+			true,
+		},
+		{
+			"null_optional_fields",
+			[]attr.Value{nodePoolValues(map[string]attr.Value{
+				"os_version_min":  types.StringNull(),
+				"max_surge":       types.Int64Null(),
+				"max_unavailable": types.Int64Null(),
+				"labels":          types.MapNull(types.StringType),
+				"taints":          types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+			})},
+			map[string]*ske.Image{
+				"node": {
+					Name:    utils.Ptr("flatcar"),
+					Version: utils.Ptr("3815.2.1"),
+				},
+			},
+			[]ske.Nodepool{
+				{
+					Name:    utils.Ptr("node"),
+					Minimum: utils.Ptr(int64(1)),
+					Maximum: utils.Ptr(int64(3)),
+					Machine: &ske.Machine{
+						Type: utils.Ptr("c1.2"),
+						Image: &ske.Image{
+							Name:    utils.Ptr("flatcar"),
+							Version: utils.Ptr("3815.2.1"),
+						},
+					},
+					Volume: &ske.Volume{
+						Type: utils.Ptr("storage_premium_perf1"),
+						Size: utils.Ptr(int64(40)),
+					},
+					Taints: &[]ske.Taint{},
+					Cri: &ske.CRI{
+						Name: ske.CRINAME_CONTAINERD.Ptr(),
+					},
+					AvailabilityZones:     &[]string{"eu01-1", "eu01-2"},
+					AllowSystemComponents: utils.Ptr(true),
+				},
+			},
+			[]string{},
+			true,
+		},
+		{
+			"os_version_and_os_version_min_set",
+			[]attr.Value{nodePoolValues(map[string]attr.Value{
+				"os_version": types.StringValue("3815.2.1"),
+			})},
+			nil,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"no_pool_allows_system_components",
+			[]attr.Value{nodePoolValues(map[string]attr.Value{
+				"allow_system_components": types.BoolValue(false),
+			})},
+			nil,
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				NodePools: types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, tt.nodePools),
+			}
+			payload, deprecatedVersions, err := toNodepoolsPayload(context.Background(), model, availableMachineVersions, tt.currentImages)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(payload, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+				diff = cmp.Diff(deprecatedVersions, tt.expectedWarning)
+				if diff != "" {
+					t.Fatalf("Deprecated versions do not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestVerifySystemComponentNodepools(t *testing.T) {
 	tests := []struct {
 		description string
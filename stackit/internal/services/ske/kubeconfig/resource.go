@@ -2,6 +2,7 @@ package ske
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -24,41 +26,90 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	KubeconfigTypeAdmin = "admin"
+	KubeconfigTypeLogin = "login"
+
+	// defaultExpiration is the expiration of admin kubeconfigs in seconds, if none is configured
+	defaultExpiration = 3600
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource               = &kubeconfigResource{}
-	_ resource.ResourceWithConfigure  = &kubeconfigResource{}
-	_ resource.ResourceWithModifyPlan = &kubeconfigResource{}
+	_ resource.Resource                   = &kubeconfigResource{}
+	_ resource.ResourceWithConfigure      = &kubeconfigResource{}
+	_ resource.ResourceWithModifyPlan     = &kubeconfigResource{}
+	_ resource.ResourceWithValidateConfig = &kubeconfigResource{}
 )
 
 type Model struct {
-	Id           types.String `tfsdk:"id"` // needed by TF
-	ClusterName  types.String `tfsdk:"cluster_name"`
-	ProjectId    types.String `tfsdk:"project_id"`
-	KubeconfigId types.String `tfsdk:"kube_config_id"` // uuid generated internally because kubeconfig has no identifier
-	Kubeconfig   types.String `tfsdk:"kube_config"`
-	Expiration   types.Int64  `tfsdk:"expiration"`
-	Refresh      types.Bool   `tfsdk:"refresh"`
-	ExpiresAt    types.String `tfsdk:"expires_at"`
-	CreationTime types.String `tfsdk:"creation_time"`
-	Region       types.String `tfsdk:"region"`
+	Id                   types.String `tfsdk:"id"` // needed by TF
+	ClusterName          types.String `tfsdk:"cluster_name"`
+	ProjectId            types.String `tfsdk:"project_id"`
+	KubeconfigId         types.String `tfsdk:"kube_config_id"` // uuid generated internally because kubeconfig has no identifier
+	Type                 types.String `tfsdk:"type"`
+	Kubeconfig           types.String `tfsdk:"kube_config"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	Token                types.String `tfsdk:"token"`
+	Expiration           types.Int64  `tfsdk:"expiration"`
+	Refresh              types.Bool   `tfsdk:"refresh"`
+	RefreshBefore        types.String `tfsdk:"refresh_before"`
+	ExpiresAt            types.String `tfsdk:"expires_at"`
+	CreationTime         types.String `tfsdk:"creation_time"`
+	Region               types.String `tfsdk:"region"`
+}
+
+// kubeconfigFile holds the parts of a kubeconfig file needed to extract the credentials of its current context
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
 }
 
 // NewKubeconfigResource is a helper function to simplify the provider implementation.
 func NewKubeconfigResource() resource.Resource {
-	return &kubeconfigResource{}
+	return &kubeconfigResource{
+		now: time.Now,
+	}
 }
 
 // kubeconfigResource is the resource implementation.
 type kubeconfigResource struct {
 	client       *ske.APIClient
 	providerData core.ProviderData
+	// now returns the current time, it is replaced in tests
+	now func() time.Time
 }
 
 // Metadata returns the resource type name.
@@ -90,10 +141,17 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		"kube_config_id": "Internally generated UUID to identify a kubeconfig resource in Terraform, since the SKE API doesnt return a kubeconfig identifier",
 		"cluster_name":   "Name of the SKE cluster.",
 		"project_id":     "STACKIT project ID to which the cluster is associated.",
-		"kube_config":    "Raw short-lived admin kubeconfig.",
-		"expiration":     "Expiration time of the kubeconfig, in seconds. Defaults to `3600`",
+		"type":           "Type of the kubeconfig. `admin` creates a short-lived admin kubeconfig with static credentials. `login` gets a kubeconfig without credentials, which obtains them through the STACKIT CLI (`stackit ske kubeconfig login`) when used. Defaults to `admin`. " + utils.FormatPossibleValues(KubeconfigTypeAdmin, KubeconfigTypeLogin),
+		"kube_config":    "Raw kubeconfig. For `admin` kubeconfigs it contains short-lived admin credentials.",
+		"host":           "Address of the Kubernetes API server of the cluster.",
+		"cluster_ca":     "PEM-encoded CA certificate of the Kubernetes API server.",
+		"client_cert":    "PEM-encoded client certificate. Only set for `admin` kubeconfigs.",
+		"client_key":     "PEM-encoded client key. Only set for `admin` kubeconfigs.",
+		"token":          "Bearer token used to authenticate against the cluster, if the kubeconfig contains one.",
+		"expiration":     "Expiration time of the kubeconfig, in seconds. Only used for `admin` kubeconfigs. Defaults to `3600`",
 		"expires_at":     "Timestamp when the kubeconfig expires",
 		"refresh":        "If set to true, the provider will check if the kubeconfig has expired and will generated a new valid one in-place",
+		"refresh_before": "Duration before `expires_at` from which the kubeconfig is considered due for renewal, e.g. `15m` or `1h`. Must be shorter than `expiration`. If the remaining validity is below this duration, a replacement of the kubeconfig is planned. Only used for `admin` kubeconfigs.",
 		"creation_time":  "Date-time when the kubeconfig was created",
 		"region":         "The resource region. If not defined, the provider region is used.",
 	}
@@ -138,11 +196,23 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					validate.NoSeparator(),
 				},
 			},
+			"type": schema.StringAttribute{
+				Description: descriptions["type"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(KubeconfigTypeAdmin),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(KubeconfigTypeAdmin, KubeconfigTypeLogin),
+				},
+			},
 			"expiration": schema.Int64Attribute{
 				Description: descriptions["expiration"],
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(3600), // the default value is not returned by the API so we set a default value here, otherwise we would have to compute the expiration based on the expires_at field
+				Default:     int64default.StaticInt64(defaultExpiration), // the default value is not returned by the API so we set a default value here, otherwise we would have to compute the expiration based on the expires_at field
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 					int64planmodifier.UseStateForUnknown(),
@@ -155,6 +225,13 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"refresh_before": schema.StringAttribute{
+				Description: descriptions["refresh_before"],
+				Optional:    true,
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+			},
 			"kube_config": schema.StringAttribute{
 				Description: descriptions["kube_config"],
 				Computed:    true,
@@ -163,6 +240,43 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"host": schema.StringAttribute{
+				Description: descriptions["host"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Description: descriptions["cluster_ca"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_certificate": schema.StringAttribute{
+				Description: descriptions["client_cert"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: descriptions["client_key"],
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: descriptions["token"],
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"expires_at": schema.StringAttribute{
 				Description: descriptions["expires_at"],
 				Computed:    true,
@@ -190,8 +304,22 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ValidateConfig validates that refresh_before is shorter than the expiration of the kubeconfig
+func (r *kubeconfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := checkRefreshBefore(model.RefreshBefore, model.Expiration); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("refresh_before"), "Invalid refresh_before", err.Error())
+	}
+}
+
 // ModifyPlan will be called in the Plan phase and will check if the plan is a creation of the resource
 // If so, show warning related to deprecated credentials endpoints
+// For existing kubeconfigs, a replacement is planned if the remaining validity is below refresh_before
 func (r *kubeconfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
 	if req.State.Raw.IsNull() {
 		// Planned to create a kubeconfig
@@ -218,6 +346,19 @@ func (r *kubeconfigResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
+	if !req.State.Raw.IsNull() {
+		renew, err := checkRenewalWindow(&planModel, r.now())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error planning kubeconfig", fmt.Sprintf("%v", err))
+			return
+		}
+		if renew {
+			tflog.Info(ctx, "SKE kubeconfig is within its renewal window, planning replacement")
+			setComputedValuesUnknown(&planModel)
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+		}
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -284,6 +425,10 @@ func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// Kubeconfigs created before the type field was introduced are admin kubeconfigs
+	if model.Type.IsNull() {
+		model.Type = types.StringValue(KubeconfigTypeAdmin)
+	}
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
 	ctx = tflog.SetField(ctx, "kube_config_id", kubeconfigUUID)
@@ -306,7 +451,7 @@ func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// check if kubeconfig has expired
-	hasExpired, err := checkHasExpired(&model, time.Now())
+	hasExpired, err := checkHasExpired(&model, r.now())
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading kubeconfig", fmt.Sprintf("%v", err))
 		return
@@ -330,18 +475,39 @@ func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest,
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading kubeconfig", fmt.Sprintf("The existing kubeconfig is invalid, creating a new one: %v", err))
 			return
 		}
-		// Set state to fully populated data
-		diags = resp.State.Set(ctx, model)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+	} else {
+		// Kubeconfigs created by older provider versions don't have the credentials fields set yet
+		err = mapKubeconfigCredentials(model.Kubeconfig.ValueString(), &model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading kubeconfig", fmt.Sprintf("Processing kubeconfig: %v", err))
 			return
 		}
 	}
 
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, "SKE kubeconfig read")
 }
 
 func (r *kubeconfigResource) createKubeconfig(ctx context.Context, model *Model) error {
+	if model.Type.ValueString() == KubeconfigTypeLogin {
+		kubeconfigResp, err := r.client.GetLoginKubeconfig(ctx, model.ProjectId.ValueString(), model.Region.ValueString(), model.ClusterName.ValueString()).Execute()
+		if err != nil {
+			return fmt.Errorf("calling API: %w", err)
+		}
+
+		err = mapLoginFields(kubeconfigResp, model, r.now(), model.Region.ValueString())
+		if err != nil {
+			return fmt.Errorf("processing API payload: %w", err)
+		}
+		return nil
+	}
+
 	// Generate API request body from model
 	payload, err := toCreatePayload(model)
 	if err != nil {
@@ -354,16 +520,26 @@ func (r *kubeconfigResource) createKubeconfig(ctx context.Context, model *Model)
 	}
 
 	// Map response body to schema
-	err = mapFields(kubeconfigResp, model, time.Now(), model.Region.ValueString())
+	err = mapFields(kubeconfigResp, model, r.now(), model.Region.ValueString())
 	if err != nil {
 		return fmt.Errorf("processing API payload: %w", err)
 	}
 	return nil
 }
 
-func (r *kubeconfigResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating kubeconfig", "Kubeconfig can't be updated")
+// Update only stores refresh_before, all other attributes require a replacement of the kubeconfig.
+func (r *kubeconfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE kubeconfig updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -412,9 +588,121 @@ func mapFields(kubeconfigResp *ske.Kubeconfig, model *Model, creationTime time.T
 	// set creation time
 	model.CreationTime = types.StringValue(creationTime.Format(time.RFC3339))
 	model.Region = types.StringValue(region)
+
+	err := mapKubeconfigCredentials(*kubeconfigResp.Kubeconfig, model)
+	if err != nil {
+		return fmt.Errorf("map kubeconfig credentials: %w", err)
+	}
 	return nil
 }
 
+func mapLoginFields(kubeconfigResp *ske.LoginKubeconfig, model *Model, creationTime time.Time, region string) error {
+	if kubeconfigResp == nil {
+		return fmt.Errorf("response is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(
+		model.ProjectId.ValueString(), model.ClusterName.ValueString(), model.KubeconfigId.ValueString(),
+	)
+
+	if kubeconfigResp.Kubeconfig == nil {
+		return fmt.Errorf("kubeconfig not present")
+	}
+
+	model.Kubeconfig = types.StringPointerValue(kubeconfigResp.Kubeconfig)
+	// login kubeconfigs don't contain credentials, so they don't expire
+	model.ExpiresAt = types.StringNull()
+	// set creation time
+	model.CreationTime = types.StringValue(creationTime.Format(time.RFC3339))
+	model.Region = types.StringValue(region)
+
+	err := mapKubeconfigCredentials(*kubeconfigResp.Kubeconfig, model)
+	if err != nil {
+		return fmt.Errorf("map kubeconfig credentials: %w", err)
+	}
+	return nil
+}
+
+// mapKubeconfigCredentials sets the host and credentials fields of the model
+// from the cluster and user referenced by the current context of the kubeconfig
+func mapKubeconfigCredentials(kubeconfig string, model *Model) error {
+	model.Host = types.StringNull()
+	model.ClusterCACertificate = types.StringNull()
+	model.ClientCertificate = types.StringNull()
+	model.ClientKey = types.StringNull()
+	model.Token = types.StringNull()
+
+	if kubeconfig == "" {
+		return nil
+	}
+
+	config := kubeconfigFile{}
+	err := yaml.Unmarshal([]byte(kubeconfig), &config)
+	if err != nil {
+		return fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+
+	var clusterName, userName string
+	for _, c := range config.Contexts {
+		if c.Name == config.CurrentContext {
+			clusterName = c.Context.Cluster
+			userName = c.Context.User
+			break
+		}
+	}
+	if clusterName == "" && len(config.Contexts) == 1 {
+		clusterName = config.Contexts[0].Context.Cluster
+		userName = config.Contexts[0].Context.User
+	}
+
+	for _, c := range config.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		if c.Cluster.Server != "" {
+			model.Host = types.StringValue(c.Cluster.Server)
+		}
+		model.ClusterCACertificate, err = decodeBase64Value(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return fmt.Errorf("decoding certificate-authority-data of cluster %q: %w", c.Name, err)
+		}
+		break
+	}
+
+	for _, u := range config.Users {
+		if u.Name != userName {
+			continue
+		}
+		model.ClientCertificate, err = decodeBase64Value(u.User.ClientCertificateData)
+		if err != nil {
+			return fmt.Errorf("decoding client-certificate-data of user %q: %w", u.Name, err)
+		}
+		model.ClientKey, err = decodeBase64Value(u.User.ClientKeyData)
+		if err != nil {
+			return fmt.Errorf("decoding client-key-data of user %q: %w", u.Name, err)
+		}
+		if u.User.Token != "" {
+			model.Token = types.StringValue(u.User.Token)
+		}
+		break
+	}
+	return nil
+}
+
+func decodeBase64Value(value string) (types.String, error) {
+	if value == "" {
+		return types.StringNull(), nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(decoded)), nil
+}
+
 func toCreatePayload(model *Model) (*ske.CreateKubeconfigPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
@@ -449,6 +737,60 @@ func checkHasExpired(model *Model, currentTime time.Time) (bool, error) {
 	return false, nil
 }
 
+// helper function to check if the remaining validity of the kubeconfig is below refresh_before
+func checkRenewalWindow(model *Model, currentTime time.Time) (bool, error) {
+	if model.RefreshBefore.IsNull() || model.RefreshBefore.IsUnknown() {
+		return false, nil
+	}
+	expiresAtValue := model.ExpiresAt
+	if expiresAtValue.IsNull() || expiresAtValue.IsUnknown() {
+		return false, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, expiresAtValue.ValueString())
+	if err != nil {
+		return false, fmt.Errorf("converting expiresAt field to timestamp: %w", err)
+	}
+	refreshBefore, err := time.ParseDuration(model.RefreshBefore.ValueString())
+	if err != nil {
+		return false, fmt.Errorf("converting refreshBefore field to duration: %w", err)
+	}
+	return !currentTime.Add(refreshBefore).Before(expiresAt), nil
+}
+
+// helper function to check that refresh_before is shorter than the expiration, otherwise a replacement would be planned on every run.
+// Unknown or invalid values are ignored, the latter are reported by the attribute validators.
+func checkRefreshBefore(refreshBeforeValue types.String, expirationValue types.Int64) error {
+	if refreshBeforeValue.IsNull() || refreshBeforeValue.IsUnknown() || expirationValue.IsUnknown() {
+		return nil
+	}
+	refreshBefore, err := time.ParseDuration(refreshBeforeValue.ValueString())
+	if err != nil {
+		return nil
+	}
+	expiration := int64(defaultExpiration)
+	if !expirationValue.IsNull() {
+		expiration = expirationValue.ValueInt64()
+	}
+	if refreshBefore >= time.Duration(expiration)*time.Second {
+		return fmt.Errorf("refresh_before (%s) must be shorter than the expiration of the kubeconfig (%ds)", refreshBefore, expiration)
+	}
+	return nil
+}
+
+// setComputedValuesUnknown marks all values which are set when a new kubeconfig is created as unknown
+func setComputedValuesUnknown(model *Model) {
+	model.Id = types.StringUnknown()
+	model.KubeconfigId = types.StringUnknown()
+	model.Kubeconfig = types.StringUnknown()
+	model.Host = types.StringUnknown()
+	model.ClusterCACertificate = types.StringUnknown()
+	model.ClientCertificate = types.StringUnknown()
+	model.ClientKey = types.StringUnknown()
+	model.Token = types.StringUnknown()
+	model.ExpiresAt = types.StringUnknown()
+	model.CreationTime = types.StringUnknown()
+}
+
 // helper function to check if a credentials rotation was done
 func checkCredentialsRotation(cluster *ske.Cluster, model *Model) (bool, error) {
 	creationTimeValue := model.CreationTime
@@ -1,16 +1,75 @@
 package ske
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/ske"
 )
 
+const testAdminKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: other
+  cluster:
+    server: https://other.example.com
+- name: shoot
+  cluster:
+    certificate-authority-data: Y2EtY2VydA==
+    server: https://api.example.com
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: other
+- name: shoot
+  context:
+    cluster: shoot
+    user: admin
+current-context: shoot
+users:
+- name: other
+  user:
+    token: other-token
+- name: admin
+  user:
+    client-certificate-data: Y2xpZW50LWNlcnQ=
+    client-key-data: Y2xpZW50LWtleQ==
+`
+
+const testLoginKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: shoot
+  cluster:
+    certificate-authority-data: Y2EtY2VydA==
+    server: https://api.example.com
+contexts:
+- name: shoot
+  context:
+    cluster: shoot
+    user: login
+current-context: shoot
+users:
+- name: login
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: stackit
+      args:
+      - ske
+      - kubeconfig
+      - login
+`
+
 func TestMapFields(t *testing.T) {
 	const testRegion = "eu01"
 	tests := []struct {
@@ -23,20 +82,34 @@ func TestMapFields(t *testing.T) {
 			"simple_values",
 			&ske.Kubeconfig{
 				ExpirationTimestamp: utils.Ptr(time.Date(2024, 2, 7, 16, 42, 12, 0, time.UTC)),
-				Kubeconfig:          utils.Ptr("kubeconfig"),
+				Kubeconfig:          utils.Ptr(testAdminKubeconfig),
 			},
 			Model{
-				ClusterName:  types.StringValue("name"),
-				ProjectId:    types.StringValue("pid"),
-				Kubeconfig:   types.StringValue("kubeconfig"),
-				Expiration:   types.Int64Null(),
-				Refresh:      types.BoolNull(),
-				ExpiresAt:    types.StringValue("2024-02-07T16:42:12Z"),
-				CreationTime: types.StringValue("2024-02-05T14:40:12Z"),
-				Region:       types.StringValue(testRegion),
+				ClusterName:          types.StringValue("name"),
+				ProjectId:            types.StringValue("pid"),
+				Kubeconfig:           types.StringValue(testAdminKubeconfig),
+				Host:                 types.StringValue("https://api.example.com"),
+				ClusterCACertificate: types.StringValue("ca-cert"),
+				ClientCertificate:    types.StringValue("client-cert"),
+				ClientKey:            types.StringValue("client-key"),
+				Token:                types.StringNull(),
+				Expiration:           types.Int64Null(),
+				Refresh:              types.BoolNull(),
+				ExpiresAt:            types.StringValue("2024-02-07T16:42:12Z"),
+				CreationTime:         types.StringValue("2024-02-05T14:40:12Z"),
+				Region:               types.StringValue(testRegion),
 			},
 			true,
 		},
+		{
+			"invalid_kubeconfig",
+			&ske.Kubeconfig{
+				ExpirationTimestamp: utils.Ptr(time.Date(2024, 2, 7, 16, 42, 12, 0, time.UTC)),
+				Kubeconfig:          utils.Ptr("kubeconfig"),
+			},
+			Model{},
+			false,
+		},
 		{
 			"nil_response",
 			nil,
@@ -82,6 +155,152 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestMapLoginFields(t *testing.T) {
+	const testRegion = "eu01"
+	tests := []struct {
+		description string
+		input       *ske.LoginKubeconfig
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&ske.LoginKubeconfig{
+				Kubeconfig: utils.Ptr(testLoginKubeconfig),
+			},
+			Model{
+				ClusterName:          types.StringValue("name"),
+				ProjectId:            types.StringValue("pid"),
+				Kubeconfig:           types.StringValue(testLoginKubeconfig),
+				Host:                 types.StringValue("https://api.example.com"),
+				ClusterCACertificate: types.StringValue("ca-cert"),
+				ClientCertificate:    types.StringNull(),
+				ClientKey:            types.StringNull(),
+				Token:                types.StringNull(),
+				ExpiresAt:            types.StringNull(),
+				CreationTime:         types.StringValue("2024-02-05T14:40:12Z"),
+				Region:               types.StringValue(testRegion),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_kubeconfig_field",
+			&ske.LoginKubeconfig{},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId:   tt.expected.ProjectId,
+				ClusterName: tt.expected.ClusterName,
+			}
+			creationTime, _ := time.Parse(time.RFC3339, tt.expected.CreationTime.ValueString())
+			err := mapLoginFields(tt.input, state, creationTime, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected, cmpopts.IgnoreFields(Model{}, "Id")) // Id includes a random uuid
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMapKubeconfigCredentials(t *testing.T) {
+	tests := []struct {
+		description string
+		kubeconfig  string
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"empty_kubeconfig",
+			"",
+			Model{
+				Host:                 types.StringNull(),
+				ClusterCACertificate: types.StringNull(),
+				ClientCertificate:    types.StringNull(),
+				ClientKey:            types.StringNull(),
+				Token:                types.StringNull(),
+			},
+			true,
+		},
+		{
+			"token_user",
+			`clusters:
+- name: c
+  cluster:
+    server: https://api.example.com
+contexts:
+- name: ctx
+  context:
+    cluster: c
+    user: u
+users:
+- name: u
+  user:
+    token: secret
+`,
+			Model{
+				Host:                 types.StringValue("https://api.example.com"),
+				ClusterCACertificate: types.StringNull(),
+				ClientCertificate:    types.StringNull(),
+				ClientKey:            types.StringNull(),
+				Token:                types.StringValue("secret"),
+			},
+			true,
+		},
+		{
+			"invalid_certificate_data",
+			`clusters:
+- name: c
+  cluster:
+    certificate-authority-data: not-base64!
+contexts:
+- name: ctx
+  context:
+    cluster: c
+    user: u
+current-context: ctx
+`,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{}
+			err := mapKubeconfigCredentials(tt.kubeconfig, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
 		description string
@@ -194,6 +413,149 @@ func TestCheckHasExpired(t *testing.T) {
 	}
 }
 
+func TestCheckRenewalWindow(t *testing.T) {
+	now := time.Date(2024, 2, 7, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description   string
+		inputModel    *Model
+		currentTime   time.Time
+		expected      bool
+		expectedError bool
+	}{
+		{
+			description: "remaining validity below refresh_before",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("30m"),
+				ExpiresAt:     types.StringValue(now.Add(10 * time.Minute).Format(time.RFC3339)),
+			},
+			currentTime:   now,
+			expected:      true,
+			expectedError: false,
+		},
+		{
+			description: "remaining validity equal to refresh_before",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("10m"),
+				ExpiresAt:     types.StringValue(now.Add(10 * time.Minute).Format(time.RFC3339)),
+			},
+			currentTime:   now,
+			expected:      true,
+			expectedError: false,
+		},
+		{
+			description: "remaining validity above refresh_before",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("5m"),
+				ExpiresAt:     types.StringValue(now.Add(10 * time.Minute).Format(time.RFC3339)),
+			},
+			currentTime:   now,
+			expected:      false,
+			expectedError: false,
+		},
+		{
+			description: "already expired",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("5m"),
+				ExpiresAt:     types.StringValue(now.Add(-1 * time.Hour).Format(time.RFC3339)),
+			},
+			currentTime:   now,
+			expected:      true,
+			expectedError: false,
+		},
+		{
+			description: "clock moved into renewal window",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("5m"),
+				ExpiresAt:     types.StringValue(now.Add(10 * time.Minute).Format(time.RFC3339)),
+			},
+			currentTime:   now.Add(6 * time.Minute),
+			expected:      true,
+			expectedError: false,
+		},
+		{
+			description: "refresh_before not set",
+			inputModel: &Model{
+				RefreshBefore: types.StringNull(),
+				ExpiresAt:     types.StringValue(now.Add(-1 * time.Hour).Format(time.RFC3339)),
+			},
+			currentTime:   now,
+			expected:      false,
+			expectedError: false,
+		},
+		{
+			description: "expires_at not set",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("5m"),
+				ExpiresAt:     types.StringNull(),
+			},
+			currentTime:   now,
+			expected:      false,
+			expectedError: false,
+		},
+		{
+			description: "invalid duration",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("5 minutes"),
+				ExpiresAt:     types.StringValue(now.Add(10 * time.Minute).Format(time.RFC3339)),
+			},
+			currentTime:   now,
+			expected:      false,
+			expectedError: true,
+		},
+		{
+			description: "invalid time",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("5m"),
+				ExpiresAt:     types.StringValue("invalid time"),
+			},
+			currentTime:   now,
+			expected:      false,
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := checkRenewalWindow(tt.inputModel, tt.currentTime)
+			if (err != nil) != tt.expectedError {
+				t.Errorf("checkRenewalWindow() error = %v, expectedError %v", err, tt.expectedError)
+				return
+			}
+			if got != tt.expected {
+				t.Errorf("checkRenewalWindow() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckRefreshBefore(t *testing.T) {
+	tests := []struct {
+		description   string
+		refreshBefore types.String
+		expiration    types.Int64
+		isValid       bool
+	}{
+		{"not_set", types.StringNull(), types.Int64Value(60), true},
+		{"shorter_than_expiration", types.StringValue("15m"), types.Int64Value(3600), true},
+		{"equal_to_expiration", types.StringValue("1h"), types.Int64Value(3600), false},
+		{"longer_than_expiration", types.StringValue("2h"), types.Int64Value(3600), false},
+		{"shorter_than_default_expiration", types.StringValue("59m"), types.Int64Null(), true},
+		{"longer_than_default_expiration", types.StringValue("61m"), types.Int64Null(), false},
+		{"unknown_expiration", types.StringValue("2h"), types.Int64Unknown(), true},
+		{"invalid_duration_ignored", types.StringValue("invalid"), types.Int64Value(3600), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := checkRefreshBefore(tt.refreshBefore, tt.expiration)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
 func TestCheckCredentialsRotation(t *testing.T) {
 	tests := []struct {
 		description   string
@@ -323,3 +685,92 @@ func TestCheckClusterRecreation(t *testing.T) {
 		})
 	}
 }
+
+func TestModifyPlanRenewalWindow(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description     string
+		expiresAt       time.Time
+		refreshBefore   types.String
+		requiresReplace bool
+	}{
+		{
+			"inside_refresh_before_window",
+			now.Add(30 * time.Minute),
+			types.StringValue("1h"),
+			true,
+		},
+		{
+			"outside_refresh_before_window",
+			now.Add(2 * time.Hour),
+			types.StringValue("1h"),
+			false,
+		},
+		{
+			"refresh_before_not_set",
+			now.Add(30 * time.Minute),
+			types.StringNull(),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctx := context.Background()
+			r := &kubeconfigResource{
+				now: func() time.Time { return now },
+			}
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			resourceSchema := schemaResp.Schema
+
+			model := Model{
+				Id:                   types.StringValue("pid,name,uuid,eu01"),
+				ClusterName:          types.StringValue("name"),
+				ProjectId:            types.StringValue("pid"),
+				KubeconfigId:         types.StringValue("uuid"),
+				Type:                 types.StringNull(),
+				Kubeconfig:           types.StringValue("kubeconfig"),
+				Host:                 types.StringNull(),
+				ClusterCACertificate: types.StringNull(),
+				ClientCertificate:    types.StringNull(),
+				ClientKey:            types.StringNull(),
+				Token:                types.StringNull(),
+				Expiration:           types.Int64Value(3 * 3600),
+				Refresh:              types.BoolValue(true),
+				RefreshBefore:        tt.refreshBefore,
+				ExpiresAt:            types.StringValue(tt.expiresAt.Format(time.RFC3339)),
+				CreationTime:         types.StringValue(now.Add(-time.Hour).Format(time.RFC3339)),
+				Region:               types.StringValue("eu01"),
+			}
+			state := tfsdk.State{Schema: resourceSchema}
+			plan := tfsdk.Plan{Schema: resourceSchema}
+			diags := state.Set(ctx, &model)
+			diags.Append(plan.Set(ctx, &model)...)
+			if diags.HasError() {
+				t.Fatalf("Setting up the request: %v", diags.Errors())
+			}
+			// tfsdk.Config can't be set from a model, the state has the same values
+			config := tfsdk.Config{Schema: resourceSchema, Raw: state.Raw}
+
+			req := resource.ModifyPlanRequest{Config: config, Plan: plan, State: state}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+
+			requiresReplace := resp.RequiresReplace.Contains(path.Root("expires_at"))
+			if requiresReplace != tt.requiresReplace {
+				t.Fatalf("Expected requires replace to be %t, got %t", tt.requiresReplace, requiresReplace)
+			}
+			var plannedKubeconfig types.String
+			diags = resp.Plan.GetAttribute(ctx, path.Root("kube_config"), &plannedKubeconfig)
+			if diags.HasError() {
+				t.Fatalf("Reading planned kube_config: %v", diags.Errors())
+			}
+			if plannedKubeconfig.IsUnknown() != tt.requiresReplace {
+				t.Fatalf("Expected planned kube_config to be unknown: %t, got %s", tt.requiresReplace, plannedKubeconfig)
+			}
+		})
+	}
+}
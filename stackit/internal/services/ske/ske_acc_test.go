@@ -303,6 +303,11 @@ func TestAccSKEMax(t *testing.T) {
 					),
 					resource.TestCheckResourceAttr("stackit_ske_kubeconfig.kubeconfig", "expiration", testutil.ConvertConfigVariable(testConfigVarsMax["expiration"])),
 					resource.TestCheckResourceAttrSet("stackit_ske_kubeconfig.kubeconfig", "expires_at"),
+					resource.TestCheckResourceAttr("stackit_ske_kubeconfig.kubeconfig", "type", "admin"),
+					resource.TestCheckResourceAttrSet("stackit_ske_kubeconfig.kubeconfig", "host"),
+					resource.TestCheckResourceAttrSet("stackit_ske_kubeconfig.kubeconfig", "cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet("stackit_ske_kubeconfig.kubeconfig", "client_certificate"),
+					resource.TestCheckResourceAttrSet("stackit_ske_kubeconfig.kubeconfig", "client_key"),
 				),
 			},
 			// 2) Data source
@@ -1,8 +1,12 @@
 package validate
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"regexp"
@@ -18,11 +22,15 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/teambition/rrule-go"
+	"gopkg.in/yaml.v3"
 )
 
 const (
 	MajorMinorVersionRegex = `^\d+\.\d+?$`
 	FullVersionRegex       = `^\d+\.\d+.\d+?$`
+
+	// MaxUserDataSize is the maximum size of base64 encoded server user data in bytes
+	MaxUserDataSize = 65535
 )
 
 type Validator struct {
@@ -88,6 +96,46 @@ func IP(allowZeroAddress bool) *Validator {
 	}
 }
 
+// IPv4 returns a validator that checks, if the given string is a valid IPv4 address.
+// The allowZeroAddress parameter defines, if 0.0.0.0 should be considered valid.
+func IPv4(allowZeroAddress bool) *Validator {
+	description := "value must be an IPv4 address"
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			ip := net.ParseIP(req.ConfigValue.ValueString())
+			if ip == nil || ip.To4() == nil || (!allowZeroAddress && net.IPv4zero.Equal(ip)) {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
+// IPv6 returns a validator that checks, if the given string is a valid IPv6 address.
+// The allowZeroAddress parameter defines, if [::] should be considered valid.
+func IPv6(allowZeroAddress bool) *Validator {
+	description := "value must be an IPv6 address"
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			ip := net.ParseIP(req.ConfigValue.ValueString())
+			if ip == nil || ip.To4() != nil || (!allowZeroAddress && net.IPv6zero.Equal(ip)) {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
 func RecordSet() *Validator {
 	const typePath = "type"
 	return &Validator{
@@ -251,6 +299,44 @@ func CIDR() *Validator {
 	}
 }
 
+// CIDRv4 returns a validator that checks, if the given string is an IPv4 prefix in CIDR notation.
+func CIDRv4() *Validator {
+	description := "value must be an IPv4 prefix in CIDR notation"
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			ip, _, err := net.ParseCIDR(req.ConfigValue.ValueString())
+			if err != nil || ip.To4() == nil {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
+// CIDRv6 returns a validator that checks, if the given string is an IPv6 prefix in CIDR notation.
+func CIDRv6() *Validator {
+	description := "value must be an IPv6 prefix in CIDR notation"
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			ip, _, err := net.ParseCIDR(req.ConfigValue.ValueString())
+			if err != nil || ip.To4() != nil {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
 func Rrule() *Validator {
 	description := "value must be in a valid RRULE format"
 
@@ -344,3 +430,52 @@ func ValidNoTrailingNewline() *Validator {
 		},
 	}
 }
+
+// UserData returns a Validator that checks server user data. The base64 encoded data must not exceed
+// MaxUserDataSize and cloud-config data, starting with "#cloud-config", must be valid YAML. Gzip compressed
+// data is decompressed before it is checked. If base64Encoded is set, the value is decoded first.
+func UserData(base64Encoded bool) *Validator {
+	description := fmt.Sprintf("value must be valid user data of at most %d bytes after base64 encoding, cloud-config data must be valid YAML", MaxUserDataSize)
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			data := []byte(req.ConfigValue.ValueString())
+			if base64Encoded {
+				decoded, err := base64.StdEncoding.DecodeString(req.ConfigValue.ValueString())
+				if err != nil {
+					resp.Diagnostics.AddAttributeError(req.Path, "Invalid user data", fmt.Sprintf("The value is not base64 encoded: %v", err))
+					return
+				}
+				data = decoded
+			}
+
+			if size := base64.StdEncoding.EncodedLen(len(data)); size > MaxUserDataSize {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid user data", fmt.Sprintf("The user data is %d bytes after base64 encoding, the maximum is %d bytes. Consider compressing it with gzip.", size, MaxUserDataSize))
+				return
+			}
+
+			// Gzip compressed data starts with the magic number 0x1f 0x8b
+			if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+				reader, err := gzip.NewReader(bytes.NewReader(data))
+				if err != nil {
+					resp.Diagnostics.AddAttributeError(req.Path, "Invalid user data", fmt.Sprintf("Decompressing gzip data: %v", err))
+					return
+				}
+				data, err = io.ReadAll(reader)
+				if err != nil {
+					resp.Diagnostics.AddAttributeError(req.Path, "Invalid user data", fmt.Sprintf("Decompressing gzip data: %v", err))
+					return
+				}
+			}
+
+			if !bytes.HasPrefix(data, []byte("#cloud-config")) {
+				return
+			}
+			var cloudConfig map[string]interface{}
+			if err := yaml.Unmarshal(data, &cloudConfig); err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid user data", fmt.Sprintf("The cloud-config is not valid YAML: %v", err))
+			}
+		},
+	}
+}
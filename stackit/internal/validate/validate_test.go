@@ -1,7 +1,11 @@
 package validate
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -152,6 +156,69 @@ func TestIP(t *testing.T) {
 	}
 }
 
+func TestIPv4(t *testing.T) {
+	tests := []struct {
+		description string
+		allowZero   bool
+		input       string
+		isValid     bool
+	}{
+		{"ok", false, "111.222.111.222", true},
+		{"IPv6", false, "2001:db8::1", false},
+		{"IPv4 mapped IPv6", false, "::ffff:10.0.0.1", true},
+		{"CIDR", false, "10.0.0.1/24", false},
+		{"Not an IP", false, "for-sure-not-an-IP", false},
+		{"valid zero", true, "0.0.0.0", true},
+		{"invalid zero", false, "0.0.0.0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			IPv4(tt.allowZero).ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestIPv6(t *testing.T) {
+	tests := []struct {
+		description string
+		allowZero   bool
+		input       string
+		isValid     bool
+	}{
+		{"ok", false, "2001:0db8:85a3:08d3::0370:7344", true},
+		{"IPv4", false, "111.222.111.222", false},
+		{"CIDR", false, "2001:db8::1/64", false},
+		{"Not an IP", false, "for-sure-not-an-IP", false},
+		{"valid zero", true, "::", true},
+		{"invalid zero", false, "::", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			IPv6(tt.allowZero).ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
 func TestRecordSet(t *testing.T) {
 	tests := []struct {
 		description string
@@ -677,6 +744,66 @@ func TestCIDR(t *testing.T) {
 	}
 }
 
+func TestCIDRv4(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{"ok", "198.51.100.0/24", true},
+		{"entire internet", "0.0.0.0/0", true},
+		{"IPv6", "2001:db8::/48", false},
+		{"no block", "198.51.100.14", false},
+		{"invalid block", "198.51.100.14/33", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			CIDRv4().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestCIDRv6(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{"ok", "2001:db8::/48", true},
+		{"all", "::/0", true},
+		{"IPv4", "198.51.100.0/24", false},
+		{"no block", "2001:db8::1", false},
+		{"invalid block", "2001:db8::/129", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			CIDRv6().ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
 func TestRrule(t *testing.T) {
 	tests := []struct {
 		description string
@@ -916,3 +1043,94 @@ func TestValidNoTrailingNewline(t *testing.T) {
 		})
 	}
 }
+
+func TestUserData(t *testing.T) {
+	gzipData := func(data string) string {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		_, _ = writer.Write([]byte(data))
+		_ = writer.Close()
+		return buf.String()
+	}
+	validCloudConfig := "#cloud-config\npackages:\n  - nginx\n"
+	invalidCloudConfig := "#cloud-config\npackages: [nginx\n"
+
+	tests := []struct {
+		description   string
+		input         string
+		base64Encoded bool
+		isValid       bool
+	}{
+		{
+			"valid cloud-config",
+			validCloudConfig,
+			false,
+			true,
+		},
+		{
+			"invalid cloud-config",
+			invalidCloudConfig,
+			false,
+			false,
+		},
+		{
+			"shell script is not parsed",
+			"#!/bin/bash\necho [",
+			false,
+			true,
+		},
+		{
+			"too large",
+			"#!/bin/bash\n" + strings.Repeat("a", MaxUserDataSize),
+			false,
+			false,
+		},
+		{
+			"base64 encoded cloud-config",
+			base64.StdEncoding.EncodeToString([]byte(validCloudConfig)),
+			true,
+			true,
+		},
+		{
+			"base64 encoded invalid cloud-config",
+			base64.StdEncoding.EncodeToString([]byte(invalidCloudConfig)),
+			true,
+			false,
+		},
+		{
+			"gzip compressed cloud-config",
+			base64.StdEncoding.EncodeToString([]byte(gzipData(validCloudConfig))),
+			true,
+			true,
+		},
+		{
+			"gzip compressed invalid cloud-config",
+			base64.StdEncoding.EncodeToString([]byte(gzipData(invalidCloudConfig))),
+			true,
+			false,
+		},
+		{
+			"not base64 encoded",
+			validCloudConfig,
+			true,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			va := UserData(tt.base64Encoded)
+			va.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Expected validation to fail for input: %q", tt.input)
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Expected validation to succeed for input: %q, but got errors: %v", tt.input, r.Diagnostics.Errors())
+			}
+		})
+	}
+}
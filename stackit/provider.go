@@ -25,7 +25,10 @@ import (
 	dnsZone "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/zone"
 	gitInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/git/instance"
 	iaasAffinityGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/affinitygroup"
+	iaasCloudinitConfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/cloudinitconfig"
 	iaasImage "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/image"
+	iaasImageShare "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/imageshare"
+	iaasImageShareConsumer "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/imageshareconsumer"
 	iaasImageV2 "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/imagev2"
 	iaasKeyPair "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/keypair"
 	machineType "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/machinetype"
@@ -38,12 +41,17 @@ import (
 	iaasPublicIp "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicip"
 	iaasPublicIpAssociate "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicipassociate"
 	iaasPublicIpRanges "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/publicipranges"
+	iaasQuota "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/quota"
 	iaasSecurityGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/securitygroup"
 	iaasSecurityGroupRule "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/securitygrouprule"
 	iaasServer "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/server"
+	iaasServerConsole "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/serverconsole"
 	iaasServiceAccountAttach "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/serviceaccountattach"
 	iaasVolume "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volume"
 	iaasVolumeAttach "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volumeattach"
+	iaasVolumeBackup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volumebackup"
+	iaasVolumeSnapshot "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaas/volumesnapshot"
+	iaasalphaRoutingTableAssociation "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/association"
 	iaasalphaRoutingTableRoute "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/route"
 	iaasalphaRoutingTableRoutes "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/routes"
 	iaasalphaRoutingTable "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/iaasalpha/routingtable/table"
@@ -457,18 +465,27 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		dnsRecordSet.NewRecordSetDataSource,
 		gitInstance.NewGitDataSource,
 		iaasAffinityGroup.NewAffinityGroupDatasource,
+		iaasAffinityGroup.NewAffinityGroupsDatasource,
+		iaasCloudinitConfig.NewCloudinitConfigDataSource,
 		iaasImage.NewImageDataSource,
 		iaasImageV2.NewImageV2DataSource,
+		iaasImageV2.NewImagesDataSource,
 		iaasNetwork.NewNetworkDataSource,
 		iaasNetworkArea.NewNetworkAreaDataSource,
+		iaasNetworkArea.NewNetworkAreaFreePrefixesDataSource,
 		iaasNetworkAreaRoute.NewNetworkAreaRouteDataSource,
 		iaasNetworkInterface.NewNetworkInterfaceDataSource,
 		iaasVolume.NewVolumeDataSource,
+		iaasVolumeBackup.NewVolumeBackupDataSource,
+		iaasVolumeSnapshot.NewVolumeSnapshotDataSource,
 		iaasProject.NewProjectDataSource,
+		iaasQuota.NewQuotasDataSource,
 		iaasPublicIp.NewPublicIpDataSource,
 		iaasPublicIpRanges.NewPublicIpRangesDataSource,
+		iaasPublicIp.NewPublicIpsDataSource,
 		iaasKeyPair.NewKeyPairDataSource,
 		iaasServer.NewServerDataSource,
+		iaasServerConsole.NewServerConsoleDataSource,
 		iaasSecurityGroup.NewSecurityGroupDataSource,
 		iaasalphaRoutingTable.NewRoutingTableDataSource,
 		iaasalphaRoutingTableRoute.NewRoutingTableRouteDataSource,
@@ -480,6 +497,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		logMeCredential.NewCredentialDataSource,
 		logAlertGroup.NewLogAlertGroupDataSource,
 		machineType.NewMachineTypeDataSource,
+		machineType.NewMachineTypesDataSource,
 		mariaDBInstance.NewInstanceDataSource,
 		mariaDBCredential.NewCredentialDataSource,
 		mongoDBFlexInstance.NewInstanceDataSource,
@@ -509,6 +527,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		serverUpdateSchedule.NewSchedulesDataSource,
 		serviceAccount.NewServiceAccountDataSource,
 		skeCluster.NewClusterDataSource,
+		skeCluster.NewClustersDataSource,
 	}
 }
 
@@ -523,22 +542,29 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		gitInstance.NewGitResource,
 		iaasAffinityGroup.NewAffinityGroupResource,
 		iaasImage.NewImageResource,
+		iaasImageShare.NewImageShareResource,
+		iaasImageShareConsumer.NewImageShareConsumerResource,
 		iaasNetwork.NewNetworkResource,
 		iaasNetworkArea.NewNetworkAreaResource,
 		iaasNetworkAreaRoute.NewNetworkAreaRouteResource,
+		iaasNetworkAreaRoute.NewNetworkAreaRoutesResource,
 		iaasNetworkInterface.NewNetworkInterfaceResource,
 		iaasVolume.NewVolumeResource,
 		iaasPublicIp.NewPublicIpResource,
 		iaasKeyPair.NewKeyPairResource,
 		iaasVolumeAttach.NewVolumeAttachResource,
+		iaasVolumeBackup.NewVolumeBackupResource,
+		iaasVolumeSnapshot.NewVolumeSnapshotResource,
 		iaasNetworkInterfaceAttach.NewNetworkInterfaceAttachResource,
 		iaasServiceAccountAttach.NewServiceAccountAttachResource,
 		iaasPublicIpAssociate.NewPublicIpAssociateResource,
 		iaasServer.NewServerResource,
 		iaasSecurityGroup.NewSecurityGroupResource,
 		iaasSecurityGroupRule.NewSecurityGroupRuleResource,
+		iaasSecurityGroupRule.NewSecurityGroupRulesResource,
 		iaasalphaRoutingTable.NewRoutingTableResource,
 		iaasalphaRoutingTableRoute.NewRoutingTableRouteResource,
+		iaasalphaRoutingTableAssociation.NewRoutingTableAssociationResource,
 		loadBalancer.NewLoadBalancerResource,
 		loadBalancerObservabilityCredential.NewObservabilityCredentialResource,
 		logMeInstance.NewInstanceResource,